@@ -0,0 +1,43 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Examples:
+// $IIACK,001*54
+
+func NewACK(m Message) *ACK {
+	return &ACK{Message: m}
+}
+
+// ACK is the legacy acknowledgement of an alarm reported by ALR (replaced by ACN in bridge alert management)
+type ACK struct {
+	Message
+
+	Identifier int // Unique alarm number at alarm source (0 ~ 999)
+}
+
+func (m *ACK) parse() (err error) {
+	if len(m.Fields) != 1 {
+		return m.Error(fmt.Errorf("Incomplete ACK message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 1))
+	}
+
+	if m.Identifier, err = strconv.Atoi(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse alarm identifier from data field (got: %s)", m.Fields[0]))
+	}
+
+	return nil
+}
+
+func (m ACK) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("ACK")
+	fields := []string{PrependToIntXZero(m.Identifier, 3)}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
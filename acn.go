@@ -0,0 +1,116 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Examples:
+// $ECACN,124305.00,,192,1,A,C*6C
+
+func NewACN(m Message) *ACN {
+	return &ACN{Message: m}
+}
+
+// ACN is the alert command sentence of bridge alert management (IEC 61162-1)
+type ACN struct {
+	Message
+
+	TimeUTC      time.Time // Time of command, zero if not available
+	TimeDigits   int       // Number of fractional digits of TimeUTC data field
+	Manufacturer string    // Manufacturer mnemonic code, empty for standardized alerts
+	Identifier   int       // Alert identifier
+	Instance     int       // Alert instance, 0 if not available
+	Command      AlertCommand
+}
+
+func (m *ACN) parse() (err error) {
+	if len(m.Fields) != 6 {
+		return m.Error(fmt.Errorf("Incomplete ACN message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 6))
+	}
+
+	if m.TimeUTC, m.TimeDigits, err = parseTimeOfDay(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[0]))
+	}
+
+	m.Manufacturer = m.Fields[1]
+
+	if m.Identifier, err = strconv.Atoi(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse alert identifier from data field (got: %s)", m.Fields[2]))
+	}
+
+	if len(m.Fields[3]) > 0 {
+		if m.Instance, err = strconv.Atoi(m.Fields[3]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse alert instance from data field (got: %s)", m.Fields[3]))
+		}
+	}
+
+	if m.Command, err = ParseAlertCommand(m.Fields[4]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse alert command from data field (got: %s)", m.Fields[4]))
+	}
+
+	if m.Fields[5] != "C" {
+		return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", 6, m.Fields[5], "C"))
+	}
+
+	return nil
+}
+
+func (m ACN) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("ACN")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		serializeTimeOfDay(m.TimeUTC, m.TimeDigits),
+		m.Manufacturer,
+		strconv.Itoa(m.Identifier),
+		serializeOptionalInt(m.Instance),
+		m.Command.Serialize(),
+		"C", // Sentence status flag, always a command
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	AlertCommandAcknowledge            AlertCommand = "A"
+	AlertCommandRequestRepeat          AlertCommand = "Q" // Request/repeat information
+	AlertCommandResponsibilityTransfer AlertCommand = "O"
+	AlertCommandSilence                AlertCommand = "S"
+)
+
+type AlertCommand string
+
+func (c AlertCommand) Serialize() string {
+	return string(c)
+}
+
+func (c AlertCommand) String() string {
+	switch c {
+	case AlertCommandAcknowledge:
+		return "Acknowledge"
+	case AlertCommandRequestRepeat:
+		return "Request/repeat information"
+	case AlertCommandResponsibilityTransfer:
+		return "Responsibility transfer"
+	case AlertCommandSilence:
+		return "Silence"
+	default:
+		return "unknow"
+	}
+}
+
+func ParseAlertCommand(raw string) (c AlertCommand, err error) {
+	c = AlertCommand(raw)
+	switch c {
+	case AlertCommandAcknowledge, AlertCommandRequestRepeat, AlertCommandResponsibilityTransfer, AlertCommandSilence:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
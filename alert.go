@@ -0,0 +1,176 @@
+package nmea
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Alert is the last known state of an alert reported by ALR or ALF messages
+type Alert struct {
+	Manufacturer string // Manufacturer mnemonic code, empty for standardized alerts
+	Identifier   int
+	Instance     int // 0 if not available (always 0 for ALR)
+	Category     AlertCategory
+	Priority     AlertPriority
+	State        AlertState
+	TimeUTC      time.Time // Time of last change
+	Revision     int
+	Text         string
+}
+
+// AlertKey identify an alert among all alert sources
+type AlertKey struct {
+	Manufacturer string
+	Identifier   int
+	Instance     int
+}
+
+// Key return the identifier of the alert
+func (a Alert) Key() AlertKey {
+	return AlertKey{Manufacturer: a.Manufacturer, Identifier: a.Identifier, Instance: a.Instance}
+}
+
+// AlertTracker keep the state of each alert which is not back to normal from ALR, ALF, ACK and ACN messages
+type AlertTracker struct {
+	alerts map[AlertKey]*Alert
+}
+
+func NewAlertTracker() *AlertTracker {
+	return &AlertTracker{alerts: make(map[AlertKey]*Alert)}
+}
+
+// Update handle an alert related message (others are ignored), commands (ACK, ACN) are applied as if accepted by the alert source
+func (t *AlertTracker) Update(msg NMEA) error {
+	switch m := msg.(type) {
+	case *ALR:
+		a := t.alert(AlertKey{Identifier: m.Identifier})
+		a.TimeUTC, a.State, a.Text = m.TimeUTC, m.State(), m.Description
+		t.cleanup(a)
+	case *ALF:
+		key := AlertKey{Manufacturer: m.Manufacturer, Identifier: m.Identifier, Instance: m.Instance}
+		if m.SequenceNumber > 1 {
+			// Additional alert text, dropped when first sentence of the alert was missed
+			if a, ok := t.alerts[key]; ok {
+				a.Text += " " + m.Text
+			}
+			return nil
+		}
+		a := t.alert(key)
+		a.TimeUTC, a.Category, a.Priority, a.State, a.Revision, a.Text = m.TimeUTC, m.Category, m.Priority, m.State, m.Revision, m.Text
+		t.cleanup(a)
+	case *ACK:
+		return t.command(AlertKey{Identifier: m.Identifier}, AlertCommandAcknowledge)
+	case *ACN:
+		return t.command(AlertKey{Manufacturer: m.Manufacturer, Identifier: m.Identifier, Instance: m.Instance}, m.Command)
+	}
+	return nil
+}
+
+// Get return the last known state of an alert
+func (t *AlertTracker) Get(key AlertKey) (Alert, bool) {
+	if a, ok := t.alerts[key]; ok {
+		return *a, true
+	}
+	return Alert{}, false
+}
+
+// Alerts return all alerts which are not back to normal ordered by identifier and instance
+func (t *AlertTracker) Alerts() []Alert {
+	alerts := make([]Alert, 0, len(t.alerts))
+	for _, a := range t.alerts {
+		alerts = append(alerts, *a)
+	}
+
+	sort.Slice(alerts, func(i, j int) bool {
+		if alerts[i].Identifier != alerts[j].Identifier {
+			return alerts[i].Identifier < alerts[j].Identifier
+		}
+		if alerts[i].Instance != alerts[j].Instance {
+			return alerts[i].Instance < alerts[j].Instance
+		}
+		return alerts[i].Manufacturer < alerts[j].Manufacturer
+	})
+	return alerts
+}
+
+// Unacknowledged return alerts which still require an acknowledgement
+func (t *AlertTracker) Unacknowledged() []Alert {
+	alerts := make([]Alert, 0)
+	for _, a := range t.Alerts() {
+		switch a.State {
+		case AlertStateActiveUnacknowledged, AlertStateActiveSilenced, AlertStateRectifiedUnacknowledged:
+			alerts = append(alerts, a)
+		}
+	}
+	return alerts
+}
+
+// Acknowledge return the ACN command to emit to acknowledge an alert
+func (t *AlertTracker) Acknowledge(key AlertKey, at time.Time) (*ACN, error) {
+	return t.newCommand(key, AlertCommandAcknowledge, at)
+}
+
+// Silence return the ACN command to emit to silence an alert
+func (t *AlertTracker) Silence(key AlertKey, at time.Time) (*ACN, error) {
+	return t.newCommand(key, AlertCommandSilence, at)
+}
+
+func (t *AlertTracker) newCommand(key AlertKey, cmd AlertCommand, at time.Time) (*ACN, error) {
+	if _, ok := t.alerts[key]; !ok {
+		return nil, fmt.Errorf("Unknown alert (got: %+v)", key)
+	}
+
+	return &ACN{
+		TimeUTC:      at,
+		TimeDigits:   2,
+		Manufacturer: key.Manufacturer,
+		Identifier:   key.Identifier,
+		Instance:     key.Instance,
+		Command:      cmd,
+	}, nil
+}
+
+func (t *AlertTracker) alert(key AlertKey) *Alert {
+	a, ok := t.alerts[key]
+	if !ok {
+		a = &Alert{Manufacturer: key.Manufacturer, Identifier: key.Identifier, Instance: key.Instance}
+		t.alerts[key] = a
+	}
+	return a
+}
+
+func (t *AlertTracker) command(key AlertKey, cmd AlertCommand) error {
+	a, ok := t.alerts[key]
+	if !ok {
+		return fmt.Errorf("Unknown alert (got: %+v)", key)
+	}
+
+	switch cmd {
+	case AlertCommandAcknowledge:
+		switch a.State {
+		case AlertStateActiveUnacknowledged, AlertStateActiveSilenced:
+			a.State = AlertStateActiveAcknowledged
+		case AlertStateRectifiedUnacknowledged:
+			a.State = AlertStateNormal
+		}
+	case AlertCommandSilence:
+		if a.State == AlertStateActiveUnacknowledged {
+			a.State = AlertStateActiveSilenced
+		}
+	case AlertCommandResponsibilityTransfer:
+		if a.State.IsActive() {
+			a.State = AlertStateActiveResponsibilityTransferred
+		}
+	}
+
+	t.cleanup(a)
+	return nil
+}
+
+// cleanup forget alert back to normal state
+func (t *AlertTracker) cleanup(a *Alert) {
+	if a.State == AlertStateNormal {
+		delete(t.alerts, a.Key())
+	}
+}
@@ -0,0 +1,87 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAlertTracker(t *testing.T) {
+	tracker := NewAlertTracker()
+
+	for _, raw := range []string{
+		"$IIALR,220516.00,001,A,V,BILGE ALARM*4F",
+		"$ECALF,1,1,0,124304.50,A,W,V,,192,1,1,0,LOST TARGET*05",
+		"$ECALF,2,2,0,,,,,,192,1,,,TARGET 12 OUT OF RANGE*50",
+		"$ECALF,1,1,1,124310.00,B,A,A,SAL,3015,,2,0,NO SPEED LOG*60",
+	} {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		if err = tracker.Update(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Additional text of an unknown alert (first sentence missed) is dropped
+	msg, err := Parse("$ECALF,2,2,5,,,,,SAL,3016,,,,PRESS ACK TO SILENCE*60")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alf := msg.(*ALF); alf.Manufacturer != "SAL" || alf.Identifier != 3016 {
+		t.Fatalf("Wrong alert of additional sentence (got: %s %d)", alf.Manufacturer, alf.Identifier)
+	}
+	if err = tracker.Update(msg); err != nil {
+		t.Fatal(err)
+	}
+
+	if alerts := tracker.Alerts(); len(alerts) != 3 {
+		t.Fatalf("Wrong number of alerts (got: %+v)", alerts)
+	}
+
+	lostTarget := AlertKey{Identifier: 192, Instance: 1}
+	if a, ok := tracker.Get(lostTarget); !ok || a.State != AlertStateActiveUnacknowledged || a.Text != "LOST TARGET TARGET 12 OUT OF RANGE" {
+		t.Fatalf("Wrong alert state (got: %+v)", a)
+	}
+
+	if unack := tracker.Unacknowledged(); len(unack) != 2 {
+		t.Fatalf("Wrong number of unacknowledged alerts (got: %+v)", unack)
+	}
+
+	// Acknowledge alert with a command emitted back on the bus
+	ack, err := tracker.Acknowledge(lostTarget, time.Date(0, 1, 1, 12, 43, 5, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if raw := ack.Serialize(); raw != "$GPACN,124305.00,,192,1,A,C*7D" {
+		t.Fatalf("Wrong acknowledgement (got: %s)", raw)
+	}
+
+	if err = tracker.Update(ack); err != nil {
+		t.Fatal(err)
+	}
+
+	if a, _ := tracker.Get(lostTarget); a.State != AlertStateActiveAcknowledged {
+		t.Fatalf("Alert should be acknowledged (got: %s)", a.State)
+	}
+
+	// Legacy alarm rectified then acknowledged is back to normal
+	for _, raw := range []string{"$IIALR,220530.00,001,V,V,BILGE ALARM*5C", "$IIACK,001*54"} {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		if err = tracker.Update(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if _, ok := tracker.Get(AlertKey{Identifier: 1}); ok {
+		t.Fatal("Alert back to normal should be forgotten")
+	}
+
+	if _, err := tracker.Silence(AlertKey{Identifier: 1}, time.Now()); err == nil {
+		t.Fatal("Unknown alert shouldn't be silenced")
+	}
+}
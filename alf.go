@@ -0,0 +1,252 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Examples:
+// $ECALF,1,1,0,124304.50,A,W,V,,192,1,1,0,LOST TARGET*05
+// $ECALF,2,2,0,,,,,,192,1,,,TARGET 12 OUT OF RANGE*50
+
+func NewALF(m Message) *ALF {
+	return &ALF{Message: m}
+}
+
+// ALF is the alert sentence of bridge alert management (IEC 61162-1), additional sentences only carry more alert text
+type ALF struct {
+	Message
+
+	NbOfMessage    int       // Total number of ALF messages for this alert (1 ~ 2)
+	SequenceNumber int       // Sequence number of this entry (1 ~ NbOfMessage)
+	MessageID      int       // Sequential message identifier (0 ~ 9) to link sentences of the same alert
+	TimeUTC        time.Time // Time of last change, zero if not available
+	TimeDigits     int       // Number of fractional digits of TimeUTC data field
+	Category       AlertCategory
+	Priority       AlertPriority
+	State          AlertState
+	Manufacturer   string // Manufacturer mnemonic code, empty for standardized alerts
+	Identifier     int    // Alert identifier
+	Instance       int    // Alert instance, 0 if not available
+	Revision       int    // Revision counter (1 ~ 99), incremented on each change of this alert
+	Escalation     int    // Escalation counter (0 ~ 9)
+	Text           string
+}
+
+func (m *ALF) parse() (err error) {
+	if len(m.Fields) != 13 {
+		return m.Error(fmt.Errorf("Incomplete ALF message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 13))
+	}
+
+	for i, v := range map[int]*int{0: &m.NbOfMessage, 1: &m.SequenceNumber, 2: &m.MessageID, 8: &m.Identifier} {
+		if *v, err = strconv.Atoi(m.Fields[i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field at %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	if m.SequenceNumber < 1 || m.SequenceNumber > m.NbOfMessage {
+		return m.Error(fmt.Errorf("Sequence number out of range (got: %d)", m.SequenceNumber))
+	}
+
+	// Optional integer data fields
+	for i, v := range map[int]*int{9: &m.Instance, 10: &m.Revision, 11: &m.Escalation} {
+		if len(m.Fields[i]) == 0 {
+			continue
+		}
+		if *v, err = strconv.Atoi(m.Fields[i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field at %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	m.Manufacturer, m.Text = m.Fields[7], m.Fields[12]
+
+	if m.SequenceNumber > 1 {
+		return nil // Additional sentence with alert text only
+	}
+
+	if m.TimeUTC, m.TimeDigits, err = parseTimeOfDay(m.Fields[3]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[3]))
+	}
+
+	if m.Category, err = ParseAlertCategory(m.Fields[4]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse alert category from data field (got: %s)", m.Fields[4]))
+	}
+
+	if m.Priority, err = ParseAlertPriority(m.Fields[5]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse alert priority from data field (got: %s)", m.Fields[5]))
+	}
+
+	if m.State, err = ParseAlertState(m.Fields[6]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse alert state from data field (got: %s)", m.Fields[6]))
+	}
+
+	return nil
+}
+
+func (m ALF) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("ALF")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		strconv.Itoa(m.NbOfMessage),
+		strconv.Itoa(m.SequenceNumber),
+		strconv.Itoa(m.MessageID),
+	)
+
+	if m.SequenceNumber > 1 {
+		fields = append(fields, "", "", "", "", m.Manufacturer, strconv.Itoa(m.Identifier), serializeOptionalInt(m.Instance), "", "")
+	} else {
+		fields = append(fields,
+			serializeTimeOfDay(m.TimeUTC, m.TimeDigits),
+			m.Category.Serialize(),
+			m.Priority.Serialize(),
+			m.State.Serialize(),
+			m.Manufacturer,
+			strconv.Itoa(m.Identifier),
+			serializeOptionalInt(m.Instance),
+			strconv.Itoa(m.Revision),
+			strconv.Itoa(m.Escalation),
+		)
+	}
+
+	fields = append(fields, m.Text)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// serializeOptionalInt return empty data field if value is zero
+func serializeOptionalInt(v int) string {
+	if v == 0 {
+		return ""
+	}
+	return strconv.Itoa(v)
+}
+
+const (
+	AlertCategoryA AlertCategory = "A" // Alerts where information at the operator unit is required for decision support
+	AlertCategoryB AlertCategory = "B" // Alerts where no additional information for decision support is necessary
+	AlertCategoryC AlertCategory = "C" // Alerts that cannot be acknowledged on the bridge
+)
+
+type AlertCategory string
+
+func (c AlertCategory) Serialize() string {
+	return string(c)
+}
+
+func (c AlertCategory) String() string {
+	switch c {
+	case AlertCategoryA, AlertCategoryB, AlertCategoryC:
+		return "Category " + string(c)
+	default:
+		return "unknow"
+	}
+}
+
+func ParseAlertCategory(raw string) (c AlertCategory, err error) {
+	c = AlertCategory(raw)
+	switch c {
+	case AlertCategoryA, AlertCategoryB, AlertCategoryC:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	AlertPriorityEmergency AlertPriority = "E" // Emergency alarm
+	AlertPriorityAlarm     AlertPriority = "A"
+	AlertPriorityWarning   AlertPriority = "W"
+	AlertPriorityCaution   AlertPriority = "C"
+)
+
+type AlertPriority string
+
+func (p AlertPriority) Serialize() string {
+	return string(p)
+}
+
+func (p AlertPriority) String() string {
+	switch p {
+	case AlertPriorityEmergency:
+		return "Emergency alarm"
+	case AlertPriorityAlarm:
+		return "Alarm"
+	case AlertPriorityWarning:
+		return "Warning"
+	case AlertPriorityCaution:
+		return "Caution"
+	default:
+		return "unknow"
+	}
+}
+
+func ParseAlertPriority(raw string) (p AlertPriority, err error) {
+	p = AlertPriority(raw)
+	switch p {
+	case AlertPriorityEmergency, AlertPriorityAlarm, AlertPriorityWarning, AlertPriorityCaution:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	AlertStateActiveUnacknowledged            AlertState = "V"
+	AlertStateActiveSilenced                  AlertState = "S"
+	AlertStateActiveAcknowledged              AlertState = "A" // Also used for active caution
+	AlertStateActiveResponsibilityTransferred AlertState = "O"
+	AlertStateRectifiedUnacknowledged         AlertState = "U"
+	AlertStateNormal                          AlertState = "N"
+)
+
+type AlertState string
+
+func (s AlertState) Serialize() string {
+	return string(s)
+}
+
+func (s AlertState) String() string {
+	switch s {
+	case AlertStateActiveUnacknowledged:
+		return "Active - unacknowledged"
+	case AlertStateActiveSilenced:
+		return "Active - silenced"
+	case AlertStateActiveAcknowledged:
+		return "Active - acknowledged"
+	case AlertStateActiveResponsibilityTransferred:
+		return "Active - responsibility transferred"
+	case AlertStateRectifiedUnacknowledged:
+		return "Rectified - unacknowledged"
+	case AlertStateNormal:
+		return "Normal"
+	default:
+		return "unknow"
+	}
+}
+
+func ParseAlertState(raw string) (s AlertState, err error) {
+	s = AlertState(raw)
+	switch s {
+	case AlertStateActiveUnacknowledged, AlertStateActiveSilenced, AlertStateActiveAcknowledged,
+		AlertStateActiveResponsibilityTransferred, AlertStateRectifiedUnacknowledged, AlertStateNormal:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+// IsActive return true while the alert condition is present
+func (s AlertState) IsActive() bool {
+	switch s {
+	case AlertStateActiveUnacknowledged, AlertStateActiveSilenced, AlertStateActiveAcknowledged, AlertStateActiveResponsibilityTransferred:
+		return true
+	default:
+		return false
+	}
+}
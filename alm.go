@@ -0,0 +1,182 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Examples:
+// $GPALM,1,1,15,1159,00,441D,4E,16BE,FD5E,A10C9F,4A2DA4,686E81,58CBE1,0A4,001*77
+
+func NewALM(m Message) *ALM {
+	return &ALM{Message: m}
+}
+
+// ALM is the almanac data of one satellite, see AlmanacAssembler to collect the complete almanac
+type ALM struct {
+	Message
+
+	NbOfMessage    int // Total number of ALM messages being output
+	SequenceNumber int // Sequence number of this entry (1 ~ NbOfMessage)
+	AlmanacEntry
+}
+
+// AlmanacEntry is the almanac data of one satellite converted to physical units (see IS-GPS-200)
+type AlmanacEntry struct {
+	PRN                  int     // Satellite PRN number (1 ~ 32)
+	Week                 int     // GPS week number
+	Health               uint8   // SV health bits
+	Eccentricity         float64 // Eccentricity (dimensionless)
+	Toa                  float64 // Almanac reference time in seconds of the GPS week
+	Inclination          float64 // Inclination angle in radian (including the 0.3 semicircle reference)
+	RateOfRightAscension float64 // Rate of right ascension in radian per second
+	SqrtA                float64 // Square root of semi-major axis in meter^1/2
+	ArgumentOfPerigee    float64 // Argument of perigee (omega) in radian
+	LongitudeOfNode      float64 // Longitude of ascending node of orbit plane at weekly epoch (OMEGA0) in radian
+	MeanAnomaly          float64 // Mean anomaly at reference time (M0) in radian
+	Af0                  float64 // Clock correction bias in seconds
+	Af1                  float64 // Clock correction drift in seconds per second
+}
+
+// almanacField describe the binary encoding of an almanac parameter in hexadecimal data field
+type almanacField struct {
+	Digits int     // Number of hex digits
+	Bits   uint    // Number of significant bits
+	Signed bool    // Two's complement encoding
+	Scale  float64 // LSB value
+	Offset float64 // Value added after scaling (before conversion to radian)
+	Radian bool    // Scaled value is expressed in semicircles
+}
+
+var (
+	almanacEccentricity = almanacField{Digits: 4, Bits: 16, Scale: math.Pow(2, -21)}
+	almanacToa          = almanacField{Digits: 2, Bits: 8, Scale: math.Pow(2, 12)}
+	almanacInclination  = almanacField{Digits: 4, Bits: 16, Signed: true, Scale: math.Pow(2, -19), Offset: 0.3, Radian: true}
+	almanacOmegaDot     = almanacField{Digits: 4, Bits: 16, Signed: true, Scale: math.Pow(2, -38), Radian: true}
+	almanacSqrtA        = almanacField{Digits: 6, Bits: 24, Scale: math.Pow(2, -11)}
+	almanacAngle        = almanacField{Digits: 6, Bits: 24, Signed: true, Scale: math.Pow(2, -23), Radian: true}
+	almanacAf0          = almanacField{Digits: 3, Bits: 11, Signed: true, Scale: math.Pow(2, -20)}
+	almanacAf1          = almanacField{Digits: 3, Bits: 11, Signed: true, Scale: math.Pow(2, -38)}
+)
+
+func (f almanacField) decode(raw string) (float64, error) {
+	if len(raw) != f.Digits {
+		return 0, fmt.Errorf("Wrong number of hex digits (got: %s, wanted: %d digits)", raw, f.Digits)
+	}
+
+	u, err := strconv.ParseUint(raw, 16, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	if u >= 1<<f.Bits {
+		return 0, fmt.Errorf("Value out of range (got: %s, wanted: %d bits)", raw, f.Bits)
+	}
+
+	i := int64(u)
+	if f.Signed && u&(1<<(f.Bits-1)) != 0 {
+		i -= 1 << f.Bits // Two's complement
+	}
+
+	v := float64(i)*f.Scale + f.Offset
+	if f.Radian {
+		v *= math.Pi
+	}
+	return v, nil
+}
+
+func (f almanacField) encode(v float64) string {
+	if f.Radian {
+		v /= math.Pi
+	}
+
+	i := int64(math.Round((v - f.Offset) / f.Scale))
+	u := uint64(i) & (1<<f.Bits - 1)
+
+	return fmt.Sprintf("%0*X", f.Digits, u)
+}
+
+func (m *ALM) parse() (err error) {
+	if len(m.Fields) != 15 {
+		return m.Error(fmt.Errorf("Incomplete ALM message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 15))
+	}
+
+	if m.NbOfMessage, err = strconv.Atoi(m.Fields[0]); err != nil {
+		return m.Error(err)
+	}
+
+	if m.SequenceNumber, err = strconv.Atoi(m.Fields[1]); err != nil {
+		return m.Error(err)
+	}
+
+	if m.SequenceNumber < 1 || m.SequenceNumber > m.NbOfMessage {
+		return m.Error(fmt.Errorf("Sequence number out of range (got: %d)", m.SequenceNumber))
+	}
+
+	if m.PRN, err = strconv.Atoi(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse satellite PRN from data field (got: %s)", m.Fields[2]))
+	}
+
+	if m.Week, err = strconv.Atoi(m.Fields[3]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse GPS week number from data field (got: %s)", m.Fields[3]))
+	}
+
+	health, err := strconv.ParseUint(m.Fields[4], 16, 8)
+	if err != nil {
+		return m.Error(fmt.Errorf("Unable to parse SV health from data field (got: %s)", m.Fields[4]))
+	}
+	m.Health = uint8(health)
+
+	for i, p := range map[int]struct {
+		Field almanacField
+		Value *float64
+	}{
+		5:  {almanacEccentricity, &m.Eccentricity},
+		6:  {almanacToa, &m.Toa},
+		7:  {almanacInclination, &m.Inclination},
+		8:  {almanacOmegaDot, &m.RateOfRightAscension},
+		9:  {almanacSqrtA, &m.SqrtA},
+		10: {almanacAngle, &m.ArgumentOfPerigee},
+		11: {almanacAngle, &m.LongitudeOfNode},
+		12: {almanacAngle, &m.MeanAnomaly},
+		13: {almanacAf0, &m.Af0},
+		14: {almanacAf1, &m.Af1},
+	} {
+		if *p.Value, err = p.Field.decode(strings.ToUpper(m.Fields[i])); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse almanac data field at %d, err: %s", i+1, err.Error()))
+		}
+	}
+
+	return nil
+}
+
+func (m ALM) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("ALM")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		strconv.Itoa(m.NbOfMessage),
+		strconv.Itoa(m.SequenceNumber),
+		PrependToIntXZero(m.PRN, 2),
+		strconv.Itoa(m.Week),
+		fmt.Sprintf("%02X", m.Health),
+		almanacEccentricity.encode(m.Eccentricity),
+		almanacToa.encode(m.Toa),
+		almanacInclination.encode(m.Inclination),
+		almanacOmegaDot.encode(m.RateOfRightAscension),
+		almanacSqrtA.encode(m.SqrtA),
+		almanacAngle.encode(m.ArgumentOfPerigee),
+		almanacAngle.encode(m.LongitudeOfNode),
+		almanacAngle.encode(m.MeanAnomaly),
+		almanacAf0.encode(m.Af0),
+		almanacAf1.encode(m.Af1),
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
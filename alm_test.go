@@ -0,0 +1,70 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+)
+
+func TestALM(t *testing.T) {
+	raw := "$GPALM,1,1,15,1159,00,441D,4E,16BE,FD5E,A10C9F,4A2DA4,686E81,58CBE1,0A4,001*77"
+
+	msg, err := Parse(raw)
+	if err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+	}
+
+	alm, ok := msg.(*ALM)
+	if !ok {
+		t.Fatalf("Wrong message type (got: %T)", msg)
+	}
+
+	for name, v := range map[string][2]float64{ // got, expected
+		"eccentricity":            {alm.Eccentricity, 0x441D * math.Pow(2, -21)},
+		"toa":                     {alm.Toa, 319488},
+		"inclination":             {alm.Inclination, (0.3 + 0x16BE*math.Pow(2, -19)) * math.Pi},
+		"rate of right ascension": {alm.RateOfRightAscension, -674 * math.Pow(2, -38) * math.Pi},
+		"sqrt(A)":                 {alm.SqrtA, 0xA10C9F / 2048.0},
+		"argument of perigee":     {alm.ArgumentOfPerigee, 0x4A2DA4 * math.Pow(2, -23) * math.Pi},
+		"longitude of asc. node":  {alm.LongitudeOfNode, 0x686E81 * math.Pow(2, -23) * math.Pi},
+		"mean anomaly":            {alm.MeanAnomaly, 0x58CBE1 * math.Pow(2, -23) * math.Pi},
+		"clock bias (af0)":        {alm.Af0, 164 * math.Pow(2, -20)},
+		"clock drift (af1)":       {alm.Af1, math.Pow(2, -38)},
+		"inclination (in degree)": {alm.Inclination * 180 / math.Pi, 55.9989},
+		"semi-major axis (in km)": {math.Round(alm.SqrtA * alm.SqrtA / 1000), 26559},
+	} {
+		if math.Abs(v[0]-v[1]) > 1e-4*math.Max(1, math.Abs(v[1])) {
+			t.Fatalf("Wrong %s (got: %g, expected: %g)", name, v[0], v[1])
+		}
+	}
+}
+
+func TestAlmanacAssembler(t *testing.T) {
+	nmeas := []string{
+		"$GPALM,2,1,01,2143,00,0D4B,90,12F1,FD46,A10D64,7C40D1,A1E95B,1B1E92,04A,000*03",
+		"$GPALM,2,2,15,1159,00,441D,4E,16BE,FD5E,A10C9F,4A2DA4,686E81,58CBE1,0A4,001*77",
+	}
+
+	assembler := NewAlmanacAssembler()
+
+	var almanac Almanac
+	for _, raw := range nmeas {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+
+		if almanac, err = assembler.Add(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if len(almanac) != 2 || almanac[1].Week != 2143 || almanac[15].Week != 1159 {
+		t.Fatalf("Wrong almanac (got: %+v)", almanac)
+	}
+
+	for i, alm := range almanac.ALM() {
+		if alm.Serialize() != nmeas[i] {
+			t.Fatalf("Unable to serialize almanac (got: \"%s\", expected: \"%s\")", alm.Serialize(), nmeas[i])
+		}
+	}
+}
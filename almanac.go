@@ -0,0 +1,69 @@
+package nmea
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Almanac is a complete set of almanac entries by satellite PRN
+type Almanac map[int]AlmanacEntry
+
+// AlmanacAssembler collect ALM messages of a multi-message sequence to rebuild the complete almanac
+type AlmanacAssembler struct {
+	nbOfMessage int
+	received    map[int]bool // Received sequence numbers
+	almanac     Almanac
+}
+
+func NewAlmanacAssembler() *AlmanacAssembler {
+	return &AlmanacAssembler{}
+}
+
+// Add handle an ALM message (others are ignored) and return the almanac when its last ALM message is received
+func (a *AlmanacAssembler) Add(msg NMEA) (Almanac, error) {
+	m, ok := msg.(*ALM)
+	if !ok {
+		return nil, nil
+	}
+
+	if a.almanac == nil || m.SequenceNumber == 1 || m.NbOfMessage != a.nbOfMessage {
+		a.reset(m.NbOfMessage) // New transmission of the almanac
+	}
+
+	if a.received[m.SequenceNumber] {
+		a.reset(0)
+		return nil, fmt.Errorf("Duplicated almanac sequence number (got: %d)", m.SequenceNumber)
+	}
+
+	a.received[m.SequenceNumber] = true
+	a.almanac[m.PRN] = m.AlmanacEntry
+
+	if len(a.received) < a.nbOfMessage {
+		return nil, nil // Wait for missing messages
+	}
+
+	almanac := a.almanac
+	a.reset(0)
+	return almanac, nil
+}
+
+func (a *AlmanacAssembler) reset(nbOfMessage int) {
+	a.nbOfMessage = nbOfMessage
+	a.received = make(map[int]bool)
+	a.almanac = make(Almanac)
+}
+
+// ALM return numbered ALM messages for each entry of the almanac ordered by PRN
+func (a Almanac) ALM() []ALM {
+	prns := make([]int, 0, len(a))
+	for prn := range a {
+		prns = append(prns, prn)
+	}
+	sort.Ints(prns)
+
+	alms := make([]ALM, 0, len(a))
+	for i, prn := range prns {
+		alms = append(alms, ALM{NbOfMessage: len(a), SequenceNumber: i + 1, AlmanacEntry: a[prn]})
+	}
+	return alms
+}
@@ -0,0 +1,79 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Examples:
+// $IIALR,220516.00,001,A,V,BILGE ALARM*4F
+
+func NewALR(m Message) *ALR {
+	return &ALR{Message: m}
+}
+
+// ALR is the legacy alarm state sentence (replaced by ALF in bridge alert management)
+type ALR struct {
+	Message
+
+	TimeUTC        time.Time // Time of alarm condition change, zero if not available
+	TimeDigits     int       // Number of fractional digits of TimeUTC data field
+	Identifier     int       // Unique alarm number at alarm source (0 ~ 999)
+	IsActive       bool      // Alarm condition, threshold exceeded ('A') or not exceeded ('V')
+	IsAcknowledged bool
+	Description    string
+}
+
+func (m *ALR) parse() (err error) {
+	if len(m.Fields) != 5 {
+		return m.Error(fmt.Errorf("Incomplete ALR message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 5))
+	}
+
+	if m.TimeUTC, m.TimeDigits, err = parseTimeOfDay(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.Identifier, err = strconv.Atoi(m.Fields[1]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse alarm identifier from data field (got: %s)", m.Fields[1]))
+	}
+
+	m.IsActive = (m.Fields[2] == "A")
+	m.IsAcknowledged = (m.Fields[3] == "A")
+	m.Description = m.Fields[4]
+
+	return nil
+}
+
+func (m ALR) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("ALR")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		serializeTimeOfDay(m.TimeUTC, m.TimeDigits),
+		PrependToIntXZero(m.Identifier, 3),
+		DataValid(m.IsActive).Serialize(),
+		DataValid(m.IsAcknowledged).Serialize(),
+		m.Description,
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// State return the alert state equivalent to the alarm condition
+func (m ALR) State() AlertState {
+	switch {
+	case m.IsActive && m.IsAcknowledged:
+		return AlertStateActiveAcknowledged
+	case m.IsActive:
+		return AlertStateActiveUnacknowledged
+	case m.IsAcknowledged:
+		return AlertStateNormal
+	default:
+		return AlertStateRectifiedUnacknowledged
+	}
+}
@@ -0,0 +1,154 @@
+package nmea
+
+import "fmt"
+
+// Examples:
+// $GPAPB,A,A,0.10,R,N,V,V,011.0,M,DEST,011.0,M,011.0,M*22
+
+func NewAPB(m Message) *APB {
+	return &APB{Message: m}
+}
+
+// APB is the autopilot sentence "B"
+type APB struct {
+	Message
+
+	IsValid                    DataValid // General warning flag (Loran-C blink or SNR warning)
+	IsCycleLockOK              DataValid // Loran-C cycle lock warning flag (always valid for GPS)
+	CrossTrackError            *float64  // Magnitude of cross-track error in nautical miles, empty if not available
+	SteerDirection             SteerDirection
+	ArrivalCircleEntered       ArrivalStatus
+	PerpendicularPassed        ArrivalStatus // Perpendicular passed at waypoint
+	OriginToDestinationBearing *float64      // Bearing origin to destination in degree, empty if not available
+	OriginToDestinationRef     BearingReference
+	DestinationWaypoint        string
+	BearingToDestination       *float64 // Bearing present position to destination in degree, empty if not available
+	BearingToDestinationRef    BearingReference
+	HeadingToSteer             *float64 // Heading to steer to destination waypoint in degree, empty if not available
+	HeadingToSteerRef          BearingReference
+	PositioningMode            PositioningMode // NMEA 2.3 and later, empty if not available
+}
+
+func (m *APB) parse() (err error) {
+	if len(m.Fields) != 14 && len(m.Fields) != 15 {
+		return m.Error(fmt.Errorf("Incomplete APB message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 14, 15))
+	}
+
+	if m.Fields[4] != "N" {
+		return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", 5, m.Fields[4], "N"))
+	}
+
+	m.IsValid = (m.Fields[0] == "A")
+	m.IsCycleLockOK = (m.Fields[1] == "A")
+
+	if m.CrossTrackError, m.SteerDirection, err = parseCrossTrackError(m.Fields[2], m.Fields[3]); err != nil {
+		return m.Error(err)
+	}
+
+	m.ArrivalCircleEntered = (m.Fields[5] == "A")
+	m.PerpendicularPassed = (m.Fields[6] == "A")
+
+	if m.OriginToDestinationBearing, m.OriginToDestinationRef, err = parseBearing(m.Fields[7], m.Fields[8]); err != nil {
+		return m.Error(err)
+	}
+
+	m.DestinationWaypoint = m.Fields[9]
+
+	if m.BearingToDestination, m.BearingToDestinationRef, err = parseBearing(m.Fields[10], m.Fields[11]); err != nil {
+		return m.Error(err)
+	}
+
+	if m.HeadingToSteer, m.HeadingToSteerRef, err = parseBearing(m.Fields[12], m.Fields[13]); err != nil {
+		return m.Error(err)
+	}
+
+	if len(m.Fields) == 15 {
+		if m.PositioningMode, err = ParsePositioningMode(m.Fields[14]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse GPS positioning mode from data field (got: %s)", m.Fields[14]))
+		}
+	}
+
+	return nil
+}
+
+func (m APB) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("APB")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		m.IsValid.Serialize(),
+		m.IsCycleLockOK.Serialize(),
+		formatOptionalFloat(m.CrossTrackError, m.field(2), "%.2f"),
+		m.SteerDirection.Serialize(),
+		"N",
+		m.ArrivalCircleEntered.Serialize(),
+		m.PerpendicularPassed.Serialize(),
+		formatOptionalFloat(m.OriginToDestinationBearing, m.field(7), "%05.1f"),
+		m.OriginToDestinationRef.Serialize(),
+		m.DestinationWaypoint,
+		formatOptionalFloat(m.BearingToDestination, m.field(10), "%05.1f"),
+		m.BearingToDestinationRef.Serialize(),
+		formatOptionalFloat(m.HeadingToSteer, m.field(12), "%05.1f"),
+		m.HeadingToSteerRef.Serialize(),
+	)
+
+	if len(m.PositioningMode) > 0 {
+		fields = append(fields, m.PositioningMode.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// parseBearing return bearing (nil if empty) and its reference (true or magnetic) from data fields
+func parseBearing(value, reference string) (bearing *float64, ref BearingReference, err error) {
+	if bearing, err = parseOptionalFloat(value); err != nil {
+		return bearing, ref, fmt.Errorf("Unable to parse bearing from data field (got: %s)", value)
+	}
+
+	if len(reference) > 0 {
+		if ref, err = ParseBearingReference(reference); err != nil {
+			return bearing, ref, fmt.Errorf("Unable to parse bearing reference from data field (got: %s)", reference)
+		}
+	}
+
+	return
+}
+
+const (
+	BearingTrue     BearingReference = "T"
+	BearingMagnetic BearingReference = "M"
+	BearingRelative BearingReference = "R" // Relative to own ship heading (see TTM)
+)
+
+type BearingReference string
+
+func (b BearingReference) Serialize() string {
+	return string(b)
+}
+
+func (b BearingReference) String() string {
+	switch b {
+	case BearingTrue:
+		return "True"
+	case BearingMagnetic:
+		return "Magnetic"
+	case BearingRelative:
+		return "Relative"
+	default:
+		return "unknow"
+	}
+}
+
+func ParseBearingReference(raw string) (b BearingReference, err error) {
+	b = BearingReference(raw)
+	switch b {
+	case BearingTrue, BearingMagnetic, BearingRelative:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
@@ -0,0 +1,72 @@
+package nmea
+
+import "fmt"
+
+// Examples:
+// $GPBOD,099.3,T,105.6,M,POINTB,POINTA*45
+// $GPBOD,097.0,T,103.2,M,POINTB,*47
+
+func NewBOD(m Message) *BOD {
+	return &BOD{Message: m}
+}
+
+// BOD is the bearing from origin waypoint to destination waypoint
+type BOD struct {
+	Message
+
+	BearingTrue         *float64 // Bearing (true) in degree, empty if not available
+	BearingMagnetic     *float64 // Bearing (magnetic) in degree, empty if not available
+	DestinationWaypoint string
+	OriginWaypoint      string // Empty when navigating from present position (Go to)
+}
+
+func (m *BOD) parse() (err error) {
+	if len(m.Fields) != 6 {
+		return m.Error(fmt.Errorf("Incomplete BOD message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 6))
+	}
+
+	// Validate fixed field
+	for i, v := range map[int]string{1: "T", 3: "M"} {
+		if m.Fields[i] != v {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], v))
+		}
+	}
+
+	if m.BearingTrue, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse true bearing from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.BearingMagnetic, err = parseOptionalFloat(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse magnetic bearing from data field (got: %s)", m.Fields[2]))
+	}
+
+	m.DestinationWaypoint, m.OriginWaypoint = m.Fields[4], m.Fields[5]
+
+	return nil
+}
+
+func (m BOD) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("BOD")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		serializeOptionalDegrees(m.BearingTrue), "T",
+		serializeOptionalDegrees(m.BearingMagnetic), "M",
+		m.DestinationWaypoint,
+		m.OriginWaypoint,
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// serializeOptionalDegrees return angle like ‘ddd.d’, empty data field if value is nil
+func serializeOptionalDegrees(v *float64) string {
+	if v == nil {
+		return ""
+	}
+	return PrependXZero(*v, "%.1f", 3)
+}
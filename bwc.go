@@ -0,0 +1,120 @@
+package nmea
+
+import (
+	"fmt"
+	"time"
+)
+
+// Examples:
+// $GPBWC,225444,4917.24,N,12309.57,W,051.9,T,031.6,M,001.3,N,004*29
+
+func NewBWC(m Message) *BWC {
+	return &BWC{Message: m}
+}
+
+// BWC is the bearing and distance to waypoint along the great circle
+type BWC struct {
+	Message
+	WaypointBearing
+}
+
+func (m *BWC) parse() (err error) {
+	if err = m.WaypointBearing.parse(m.Fields); err != nil {
+		return m.Error(err)
+	}
+	return nil
+}
+
+func (m BWC) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("BWC")
+	fields := m.WaypointBearing.serialize(m.Message)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// WaypointBearing is the bearing and distance from present position to a waypoint (see BWC and BWR)
+type WaypointBearing struct {
+	TimeUTC           time.Time // Aggregation of TimeUTC data field
+	TimeDigits        int       // Number of fractional digits of TimeUTC data field
+	WaypointLatitude  LatLong   // In decimal format
+	WaypointLongitude LatLong   // In decimal format
+	LatitudeDecimals  *int      // Number of decimals of minutes in latitude data field (NoCoordinate if empty), DefaultCoordinateDecimals if not specified (nil)
+	LongitudeDecimals *int      // Number of decimals of minutes in longitude data field (NoCoordinate if empty), DefaultCoordinateDecimals if not specified (nil)
+	BearingTrue       *float64  // Bearing (true) in degree, empty if not available
+	BearingMagnetic   *float64  // Bearing (magnetic) in degree, empty if not available
+	Distance          *float64  // Distance in nautical miles, empty if not available
+	Waypoint          string
+	PositioningMode   PositioningMode // NMEA 2.3 and later, empty if not available
+}
+
+func (w *WaypointBearing) parse(fields []string) (err error) {
+	if len(fields) != 12 && len(fields) != 13 {
+		return fmt.Errorf("Incomplete message, not enougth data fields (got: %d, wanted: %d)", len(fields), 13)
+	}
+
+	// Validate fixed field
+	for i, v := range map[int]string{6: "T", 8: "M", 10: "N"} {
+		if fields[i] != v {
+			return fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, fields[i], v)
+		}
+	}
+
+	if w.TimeUTC, w.TimeDigits, err = parseTimeOfDay(fields[0]); err != nil {
+		return fmt.Errorf("Unable to parse time UTC from data field (got: %s)", fields[0])
+	}
+
+	if w.WaypointLatitude, w.LatitudeDecimals, err = parseDMField(fields[1], fields[2]); err != nil {
+		return
+	}
+
+	if w.WaypointLongitude, w.LongitudeDecimals, err = parseDMField(fields[3], fields[4]); err != nil {
+		return
+	}
+
+	if w.BearingTrue, err = parseOptionalFloat(fields[5]); err != nil {
+		return fmt.Errorf("Unable to parse true bearing from data field (got: %s)", fields[5])
+	}
+
+	if w.BearingMagnetic, err = parseOptionalFloat(fields[7]); err != nil {
+		return fmt.Errorf("Unable to parse magnetic bearing from data field (got: %s)", fields[7])
+	}
+
+	if w.Distance, err = parseOptionalFloat(fields[9]); err != nil {
+		return fmt.Errorf("Unable to parse distance from data field (got: %s)", fields[9])
+	}
+
+	w.Waypoint = fields[11]
+
+	if len(fields) == 13 {
+		if w.PositioningMode, err = ParsePositioningMode(fields[12]); err != nil {
+			return fmt.Errorf("Unable to parse GPS positioning mode from data field (got: %s)", fields[12])
+		}
+	}
+
+	return nil
+}
+
+// serialize return data fields, numbers keep precision of data fields of the parsed message m
+func (w WaypointBearing) serialize(m Message) []string {
+	fields := make([]string, 0)
+
+	fields = append(fields, serializeTimeOfDay(w.TimeUTC, w.TimeDigits))
+	fields = append(fields, serializeDMField(w.WaypointLatitude, true, w.LatitudeDecimals)...)
+	fields = append(fields, serializeDMField(w.WaypointLongitude, false, w.LongitudeDecimals)...)
+	fields = append(fields,
+		formatOptionalFloat(w.BearingTrue, m.field(5), "%05.1f"), "T",
+		formatOptionalFloat(w.BearingMagnetic, m.field(7), "%05.1f"), "M",
+		formatOptionalFloat(w.Distance, m.field(9), "%05.1f"), "N",
+		w.Waypoint,
+	)
+
+	if len(w.PositioningMode) > 0 {
+		fields = append(fields, w.PositioningMode.Serialize())
+	}
+
+	return fields
+}
@@ -0,0 +1,32 @@
+package nmea
+
+// Examples:
+// $GPBWR,225444,4917.24,N,12309.57,W,051.9,T,031.6,M,001.3,N,004,A*55
+
+func NewBWR(m Message) *BWR {
+	return &BWR{Message: m}
+}
+
+// BWR is the bearing and distance to waypoint along the rhumb line
+type BWR struct {
+	Message
+	WaypointBearing
+}
+
+func (m *BWR) parse() (err error) {
+	if err = m.WaypointBearing.parse(m.Fields); err != nil {
+		return m.Error(err)
+	}
+	return nil
+}
+
+func (m BWR) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("BWR")
+	fields := m.WaypointBearing.serialize(m.Message)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
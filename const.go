@@ -25,6 +25,20 @@ const (
 	TalkerIDGB          TalkerID = "GB" // BeiDou (China)
 	TalkerIDBD          TalkerID = "BD" // BeiDou (China)
 	TalkerIDQZ          TalkerID = "QZ" // QZSS regional GPS augmentation system (Japan)
+	TalkerIDGQ          TalkerID = "GQ" // QZSS regional GPS augmentation system (Japan), according to NMEA 4.11
+	TalkerIDHC          TalkerID = "HC" // Heading, magnetic compass
+	TalkerIDHE          TalkerID = "HE" // Heading, north seeking gyro
+	TalkerIDHN          TalkerID = "HN" // Heading, non north seeking gyro
+	TalkerIDWI          TalkerID = "WI" // Weather instruments
+	TalkerIDVW          TalkerID = "VW" // Velocity sensor, speed log, water, mechanical
+	TalkerIDSD          TalkerID = "SD" // Sounder, depth
+	TalkerIDYX          TalkerID = "YX" // Transducer
+	TalkerIDTI          TalkerID = "TI" // Turn rate indicator
+	TalkerIDER          TalkerID = "ER" // Engine room monitoring systems
+	TalkerIDAG          TalkerID = "AG" // Autopilot, general
+	TalkerIDAP          TalkerID = "AP" // Autopilot, magnetic
+	TalkerIDRA          TalkerID = "RA" // RADAR and/or ARPA
+	TalkerIDCC          TalkerID = "CC" // Computer, programmed calculator
 )
 
 type TypeID struct {
@@ -49,19 +63,65 @@ func (t MtkTypeID) Serialize() string {
 	return t.TypeID.Serialize() + t.PacketType
 }
 
+// QueryTypeID is the header of a query sentence, Talker is the requester and Target the talker asked to output a sentence
+type QueryTypeID struct {
+	TypeID
+	Target TalkerID
+}
+
+func (t QueryTypeID) Serialize() string {
+	return t.Talker.Serialize() + t.Target.Serialize() + t.Code
+}
+
 type TalkerID string
 
 func (t TalkerID) Serialize() string {
 	return string(t)
 }
 
+// TalkerIDs is a dictionary of talkers allowed to emit a standard sentence (see TypeIDs)
+var TalkerIDs map[TalkerID]struct{}
+
 // TypeIDs is a dictionary of all kind of NMEA message header by full-code
 var TypeIDs map[string]Header
 
 func init() {
+	TalkerIDs = map[TalkerID]struct{}{
+		TalkerIDGPS: {},
+		TalkerIDLC:  {},
+		TalkerIDII:  {},
+		TalkerIDIN:  {},
+		TalkerIDEC:  {},
+		TalkerIDCD:  {},
+		TalkerIDGA:  {},
+		TalkerIDGL:  {},
+		TalkerIDGN:  {},
+		TalkerIDGB:  {},
+		TalkerIDBD:  {},
+		TalkerIDQZ:  {},
+		TalkerIDGQ:  {},
+		TalkerIDHC:  {},
+		TalkerIDHE:  {},
+		TalkerIDHN:  {},
+		TalkerIDWI:  {},
+		TalkerIDVW:  {},
+		TalkerIDSD:  {},
+		TalkerIDYX:  {},
+		TalkerIDTI:  {},
+		TalkerIDER:  {},
+		TalkerIDAG:  {},
+		TalkerIDAP:  {},
+		TalkerIDRA:  {},
+		TalkerIDCC:  {},
+	}
+
 	TypeIDs = map[string]Header{
 		"GPAAM":   TypeID{Talker: TalkerIDGPS, Code: "AAM"},                                               // Waypoint Arrival Alarm
+		"GPACK":   TypeID{Talker: TalkerIDGPS, Code: "ACK"},                                               // Acknowledge Alarm
+		"GPACN":   TypeID{Talker: TalkerIDGPS, Code: "ACN"},                                               // Alert Command
+		"GPALF":   TypeID{Talker: TalkerIDGPS, Code: "ALF"},                                               // Alert Sentence
 		"GPALM":   TypeID{Talker: TalkerIDGPS, Code: "ALM"},                                               // GPS Almanac Data
+		"GPALR":   TypeID{Talker: TalkerIDGPS, Code: "ALR"},                                               // Set Alarm State
 		"GPAPA":   TypeID{Talker: TalkerIDGPS, Code: "APA"},                                               // Autopilot Sentence "A"
 		"GPAPB":   TypeID{Talker: TalkerIDGPS, Code: "APB"},                                               // Autopilot Sentence "B"
 		"GPASD":   TypeID{Talker: TalkerIDGPS, Code: "ASD"},                                               // Autopilot System Data
@@ -70,6 +130,7 @@ func init() {
 		"GPBWC":   TypeID{Talker: TalkerIDGPS, Code: "BWC"},                                               // Bearing & Distance to Waypoint, Great Circle
 		"GPBWR":   TypeID{Talker: TalkerIDGPS, Code: "BWR"},                                               // Bearing & Distance to Waypoint, Rhumb Line
 		"GPBWW":   TypeID{Talker: TalkerIDGPS, Code: "BWW"},                                               // Bearing, Waypoint to Waypoint
+		"GPDBS":   TypeID{Talker: TalkerIDGPS, Code: "DBS"},                                               // Depth Below Surface
 		"GPDBT":   TypeID{Talker: TalkerIDGPS, Code: "DBT"},                                               // Depth Below Transducer
 		"GPDCN":   TypeID{Talker: TalkerIDGPS, Code: "DCN"},                                               // Decca Position
 		"GPDPT":   TypeID{Talker: TalkerIDGPS, Code: "DPT"},                                               // Depth
@@ -77,13 +138,16 @@ func init() {
 		"GPGGA":   TypeID{Talker: TalkerIDGPS, Code: "GGA"},                                               // Global Positioning System Fix Data
 		"GPGLC":   TypeID{Talker: TalkerIDGPS, Code: "GLC"},                                               // Geographic Position, Loran-C
 		"GPGLL":   TypeID{Talker: TalkerIDGPS, Code: "GLL"},                                               // Geographic Position, Latitude/Longitude
+		"GPGNS":   TypeID{Talker: TalkerIDGPS, Code: "GNS"},                                               // GNSS Fix Data
 		"GPGSA":   TypeID{Talker: TalkerIDGPS, Code: "GSA"},                                               // GPS DOP and Active Satellites
 		"GPGSV":   TypeID{Talker: TalkerIDGPS, Code: "GSV"},                                               // GPS Satellites in View
 		"GPGXA":   TypeID{Talker: TalkerIDGPS, Code: "GXA"},                                               // TRANSIT Position
 		"GPHDG":   TypeID{Talker: TalkerIDGPS, Code: "HDG"},                                               // Heading, Deviation & Variation
+		"GPHDM":   TypeID{Talker: TalkerIDGPS, Code: "HDM"},                                               // Heading, Magnetic
 		"GPHDT":   TypeID{Talker: TalkerIDGPS, Code: "HDT"},                                               // Heading, True
 		"GPHSC":   TypeID{Talker: TalkerIDGPS, Code: "HSC"},                                               // Heading Steering Command
 		"GPLCD":   TypeID{Talker: TalkerIDGPS, Code: "LCD"},                                               // Loran-C Signal Data
+		"GPMDA":   TypeID{Talker: TalkerIDGPS, Code: "MDA"},                                               // Meteorological Composite
 		"GPMTA":   TypeID{Talker: TalkerIDGPS, Code: "MTA"},                                               // Air Temperature (to be phased out)
 		"GPMTW":   TypeID{Talker: TalkerIDGPS, Code: "MTW"},                                               // Water Temperature
 		"GPMWD":   TypeID{Talker: TalkerIDGPS, Code: "MWD"},                                               // Wind Direction
@@ -102,6 +166,8 @@ func init() {
 		"GPSFI":   TypeID{Talker: TalkerIDGPS, Code: "SFI"},                                               // Scanning Frequency Information
 		"GPSTN":   TypeID{Talker: TalkerIDGPS, Code: "STN"},                                               // Multiple Data ID
 		"GPTRF":   TypeID{Talker: TalkerIDGPS, Code: "TRF"},                                               // Transit Fix Data
+		"GPTHS":   TypeID{Talker: TalkerIDGPS, Code: "THS"},                                               // True Heading and Status
+		"GPTLL":   TypeID{Talker: TalkerIDGPS, Code: "TLL"},                                               // Target Latitude and Longitude
 		"GPTTM":   TypeID{Talker: TalkerIDGPS, Code: "TTM"},                                               // Tracked Target Message
 		"GPTXT":   TypeID{Talker: TalkerIDGPS, Code: "TXT"},                                               // Tracked Status of External Antenna
 		"GPVBW":   TypeID{Talker: TalkerIDGPS, Code: "VBW"},                                               // Dual Ground/Water Speed
@@ -110,6 +176,7 @@ func init() {
 		"GPVLW":   TypeID{Talker: TalkerIDGPS, Code: "VLW"},                                               // Distance Traveled through the Water
 		"GPVPW":   TypeID{Talker: TalkerIDGPS, Code: "VPW"},                                               // Speed, Measured Parallel to Wind
 		"GPVTG":   TypeID{Talker: TalkerIDGPS, Code: "VTG"},                                               // Track Made Good and Ground Speed
+		"GPVWR":   TypeID{Talker: TalkerIDGPS, Code: "VWR"},                                               // Relative Wind Speed and Angle (legacy)
 		"GPWCV":   TypeID{Talker: TalkerIDGPS, Code: "WCV"},                                               // Waypoint Closure Velocity
 		"GPWNC":   TypeID{Talker: TalkerIDGPS, Code: "WNC"},                                               // Distance, Waypoint to Waypoint
 		"GPWPL":   TypeID{Talker: TalkerIDGPS, Code: "WPL"},                                               // Waypoint Location
@@ -172,6 +239,12 @@ const (
 	NoFixMode           PositioningMode = "N"
 	AutonomousGNSSFix   PositioningMode = "A"
 	DifferentialGNSSFix PositioningMode = "D"
+	EstimatedFix        PositioningMode = "E" // Dead reckoning
+	FloatRTKFix         PositioningMode = "F"
+	ManualInputMode     PositioningMode = "M"
+	PreciseFix          PositioningMode = "P"
+	RTKFix              PositioningMode = "R" // Fixed integer RTK
+	SimulatorMode       PositioningMode = "S"
 )
 
 type PositioningMode string
@@ -188,6 +261,18 @@ func (p PositioningMode) String() string {
 		return "Autonomous GNSS fix"
 	case DifferentialGNSSFix:
 		return "Differential GNSS fix"
+	case EstimatedFix:
+		return "Estimated (dead reckoning) fix"
+	case FloatRTKFix:
+		return "Float RTK fix"
+	case ManualInputMode:
+		return "Manual input"
+	case PreciseFix:
+		return "Precise fix"
+	case RTKFix:
+		return "RTK fix"
+	case SimulatorMode:
+		return "Simulator"
 	default:
 		return "unknow"
 	}
@@ -196,13 +281,70 @@ func (p PositioningMode) String() string {
 func ParsePositioningMode(raw string) (pm PositioningMode, err error) {
 	pm = PositioningMode(raw)
 	switch pm {
-	case NoFixMode, AutonomousGNSSFix, DifferentialGNSSFix:
+	case NoFixMode, AutonomousGNSSFix, DifferentialGNSSFix, EstimatedFix, FloatRTKFix, ManualInputMode, PreciseFix, RTKFix, SimulatorMode:
 	default:
 		err = fmt.Errorf("unknow value")
 	}
 	return
 }
 
+const (
+	SafeStatus     NavigationalStatus = "S"
+	CautionStatus  NavigationalStatus = "C"
+	UnsafeStatus   NavigationalStatus = "U"
+	NotValidStatus NavigationalStatus = "V"
+)
+
+// NavigationalStatus is the integrity status of a fix (NMEA 4.1 and later)
+type NavigationalStatus string
+
+func (s NavigationalStatus) Serialize() string {
+	return string(s)
+}
+
+func (s NavigationalStatus) String() string {
+	switch s {
+	case SafeStatus:
+		return "Safe"
+	case CautionStatus:
+		return "Caution"
+	case UnsafeStatus:
+		return "Unsafe"
+	case NotValidStatus:
+		return "Not valid"
+	default:
+		return "unknow"
+	}
+}
+
+func ParseNavigationalStatus(raw string) (s NavigationalStatus, err error) {
+	s = NavigationalStatus(raw)
+	switch s {
+	case SafeStatus, CautionStatus, UnsafeStatus, NotValidStatus:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	Version23  Version = 230
+	Version30  Version = 300
+	Version40  Version = 400
+	Version410 Version = 410
+	Version411 Version = 411
+
+	// LatestVersion is the most recent revision of the standard handled by this package
+	LatestVersion = Version411
+)
+
+// Version is a revision of the NMEA 0183 standard as major * 100 + minor (ie: 410 for NMEA 4.10)
+type Version int
+
+func (v Version) String() string {
+	return fmt.Sprintf("%d.%02d", v/100, v%100)
+}
+
 const (
 	ERROR   Severity = "00"
 	WARNING Severity = "01"
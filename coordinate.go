@@ -36,6 +36,13 @@ func ParseCardinalPoint(raw string) (cp CardinalPoint, err error) {
 	return
 }
 
+const (
+	// NoCoordinate is the number of decimals of an empty coordinate data field
+	NoCoordinate = -1
+	// DefaultCoordinateDecimals is the number of decimals of minutes used when not specified (nil)
+	DefaultCoordinateDecimals = 4
+)
+
 const (
 	// LatLong Thresholds (ie: spherical degrees)
 	// Min is the minimum value allowed for a LatLong
@@ -49,9 +56,18 @@ type LatLong float64
 // NewLatLong parses input has coordinate or return error
 //
 // Allowed format:
-// - DMS (Degrees, Minutes, Secondes), ie: "N 31° 50' 72.38'"
-// - DD (Decimal Degree), ie: "31.8534389" "22.870216666666668"
+// - DMS (Degrees, Minutes, Secondes), ie: "N 31° 50' 43.43\""
+// - DDM (Degrees, Decimal Minutes), ie: "N 31° 50.7238'"
+// - DD (Decimal Degree), ie: "31.8534389" "22.870216666666668" "40.7127753 N"
+//
+// Plain numbers are always decimal degrees, even with a hemisphere (use ParseDM for ‘ddmm.mmmm’ from GPS module)
 func NewLatLong(raw string) (l LatLong, err error) {
+	l, _, err = parseLatLong(raw)
+	return
+}
+
+// parseLatLong return coordinate and its hemisphere (empty if signed) from any format allowed by NewLatLong
+func parseLatLong(raw string) (l LatLong, hemisphere CardinalPoint, err error) {
 
 	if raw = strings.TrimSpace(raw); len(raw) == 0 {
 		err = fmt.Errorf("Invalid LatLong, can't be empty")
@@ -61,11 +77,9 @@ func NewLatLong(raw string) (l LatLong, err error) {
 	var value float64
 	if value, err = strconv.ParseFloat(raw, 64); err == nil {
 		l = LatLong(value)
-	} else {
-		// Try another format like DM
-		if l, err = ParseDM(raw); err != nil {
-			return
-		}
+	} else if l, hemisphere, err = ParseCoordinate(raw); err != nil {
+		// Other formats like DD with hemisphere, DDM or DMS with symbols
+		return
 	}
 
 	if l < Min || l > Max {
@@ -159,13 +173,14 @@ func (l LatLong) DMS() (int, int, float64) {
 	d, m := l.DM()
 	m = math.Floor(m)
 
-	// TODO: round secondes
+	// Seconds aren't rounded, see Format to get rounding carried to minutes and degrees
 	s := ((float64(l) - (float64(d) + (m / 60))) * 60 * 60)
 
 	return d, int(m), s
 }
 
 // Serialize return string like ‘ddmm.mmmm’: degree and minutes as GPS module provide
+// (trailing zeros are trimmed, use SerializeDM to get zero padded data field with expected precision)
 func (l LatLong) Serialize() string {
 	if l == 0 {
 		return ""
@@ -174,6 +189,72 @@ func (l LatLong) Serialize() string {
 	return strings.Trim(fmt.Sprintf("%d%f", d, m), "0")
 }
 
+// SerializeDM return string like ‘ddmm.mmmm’ for latitude or ‘dddmm.mmmm’ for longitude with expected number of decimals for minutes
+func (l LatLong) SerializeDM(isLatitude bool, decimals int) string {
+	d, m := l.DM()
+
+	// Round minutes before formatting to carry 60 minutes to degrees
+	pow := math.Pow(10, float64(decimals))
+	if m = math.Round(m*pow) / pow; m >= 60 {
+		d, m = d+1, m-60
+	}
+
+	width, minWidth := 2, 2
+	if !isLatitude {
+		width = 3
+	}
+	if decimals > 0 {
+		minWidth += decimals + 1 // +1 for dot
+	}
+
+	return fmt.Sprintf("%0*d%0*.*f", width, d, minWidth, decimals, m)
+}
+
+// parseDMField return coordinate and number of decimals of minutes from the pair of data fields (value and cardinal point),
+// NoCoordinate decimals if empty
+func parseDMField(value, cardinalPoint string) (l LatLong, decimals *int, err error) {
+	n := NoCoordinate
+	decimals = &n
+
+	raw := strings.TrimSpace(value + " " + cardinalPoint)
+	if len(raw) == 0 {
+		return
+	}
+
+	if l, err = ParseDM(raw); err != nil {
+		return
+	}
+	if l < Min || l > Max {
+		err = fmt.Errorf("invalid range (got: %f)", l)
+		return
+	}
+
+	if n = 0; strings.Contains(value, ".") {
+		n = len(value) - strings.Index(value, ".") - 1
+	}
+	return
+}
+
+// serializeDMField return the pair of data fields (value and cardinal point) with expected number of decimals of minutes
+// (DefaultCoordinateDecimals if nil), empty if decimals is NoCoordinate (zero coordinate is serialized like any other value)
+func serializeDMField(l LatLong, isLatitude bool, decimals *int) []string {
+	n := DefaultCoordinateDecimals
+	if decimals != nil {
+		n = *decimals
+	}
+	if n < 0 {
+		return []string{"", ""}
+	}
+
+	cp := l.CardinalPoint(isLatitude)
+	if l == 0 {
+		if cp = East; isLatitude {
+			cp = North
+		}
+	}
+	return []string{l.SerializeDM(isLatitude, n), cp.String()}
+}
+
 func (l LatLong) ToDM() string {
 	if l == 0 {
 		return ""
@@ -182,7 +263,160 @@ func (l LatLong) ToDM() string {
 }
 
 // PrintDMS return string like: dd° mm' ss.ss" to be human readable
+// (see Format to choose precision with rounding carried to minutes and degrees)
 func (l LatLong) ToDMS() string {
-	degrees, minutes, secondes := l.DMS()
-	return fmt.Sprintf("%d° %d' %.3f\"", degrees, minutes, Round(secondes, 3, .8))
+	return l.format(DMS, 3)
+}
+
+const (
+	// MinLatitude is the minimum value allowed for a Latitude
+	MinLatitude Latitude = -90
+	// MaxLatitude is the maximum value allowed for a Latitude
+	MaxLatitude Latitude = 90
+	// MinLongitude is the minimum value allowed for a Longitude
+	MinLongitude Longitude = -180
+	// MaxLongitude is the maximum value allowed for a Longitude
+	MaxLongitude Longitude = 180
+)
+
+// Latitude is a LatLong restricted to the north-south axis (negative in southern hemisphere)
+type Latitude float64
+
+// NewLatitude parses input as latitude (same formats as NewLatLong) or return error
+// when out of range or expressed with an east/west cardinal point
+func NewLatitude(raw string) (Latitude, error) {
+	l, hemisphere, err := parseLatLong(raw)
+	if err != nil {
+		return 0, err
+	}
+	if err = checkCardinalPoint(hemisphere, North, South); err != nil {
+		return 0, err
+	}
+	return l.Latitude()
+}
+
+// Latitude return coordinate as latitude or error when out of range
+func (l LatLong) Latitude() (Latitude, error) {
+	if lat := Latitude(l); lat >= MinLatitude && lat <= MaxLatitude {
+		return lat, nil
+	}
+	return 0, fmt.Errorf("invalid latitude range (got: %f)", l)
+}
+
+// LatLong return latitude as generic coordinate
+func (l Latitude) LatLong() LatLong {
+	return LatLong(l)
+}
+
+// CardinalPoint return North or South, empty if zero
+func (l Latitude) CardinalPoint() CardinalPoint {
+	return l.LatLong().CardinalPoint(true)
+}
+
+// SerializeDM return string like ‘ddmm.mmmm’ with expected number of decimals for minutes
+func (l Latitude) SerializeDM(decimals int) string {
+	return l.LatLong().SerializeDM(true, decimals)
+}
+
+// ToDMS return string like: dd° mm' ss.sss" N to be human readable (hemisphere suffix like Format)
+func (l Latitude) ToDMS() string {
+	return l.Format(DMS, 3)
+}
+
+// Format return human readable latitude with hemisphere suffix (see LatLong.Format)
+func (l Latitude) Format(format CoordinateFormat, precision int) string {
+	return l.LatLong().Format(format, true, precision)
+}
+
+// Longitude is a LatLong restricted to the east-west axis (negative in western hemisphere)
+type Longitude float64
+
+// NewLongitude parses input as longitude (same formats as NewLatLong) or return error
+// when out of range or expressed with a north/south cardinal point
+func NewLongitude(raw string) (Longitude, error) {
+	l, hemisphere, err := parseLatLong(raw)
+	if err != nil {
+		return 0, err
+	}
+	if err = checkCardinalPoint(hemisphere, East, West); err != nil {
+		return 0, err
+	}
+	return l.Longitude()
+}
+
+// Longitude return coordinate as longitude or error when out of range
+func (l LatLong) Longitude() (Longitude, error) {
+	if long := Longitude(l); long >= MinLongitude && long <= MaxLongitude {
+		return long, nil
+	}
+	return 0, fmt.Errorf("invalid longitude range (got: %f)", l)
+}
+
+// LatLong return longitude as generic coordinate
+func (l Longitude) LatLong() LatLong {
+	return LatLong(l)
+}
+
+// CardinalPoint return East or West, empty if zero
+func (l Longitude) CardinalPoint() CardinalPoint {
+	return l.LatLong().CardinalPoint(false)
+}
+
+// SerializeDM return string like ‘dddmm.mmmm’ with expected number of decimals for minutes
+func (l Longitude) SerializeDM(decimals int) string {
+	return l.LatLong().SerializeDM(false, decimals)
+}
+
+// ToDMS return string like: ddd° mm' ss.sss" E to be human readable (hemisphere suffix like Format)
+func (l Longitude) ToDMS() string {
+	return l.Format(DMS, 3)
+}
+
+// Format return human readable longitude with hemisphere suffix (see LatLong.Format)
+func (l Longitude) Format(format CoordinateFormat, precision int) string {
+	return l.LatLong().Format(format, false, precision)
+}
+
+// checkCardinalPoint return error if cardinal point isn't one of allowed ones, nil if empty (signed coordinate)
+func checkCardinalPoint(cp CardinalPoint, allowed ...CardinalPoint) error {
+	if len(cp) == 0 {
+		return nil
+	}
+
+	for _, a := range allowed {
+		if cp == a {
+			return nil
+		}
+	}
+	return fmt.Errorf("Wrong cardinal point (got: %s, wanted: %s or %s)", cp, allowed[0], allowed[1])
+}
+
+// parseLatitudeField return latitude and number of decimals of minutes from the pair of data fields, NoCoordinate decimals if empty
+func parseLatitudeField(value, cardinalPoint string) (Latitude, *int, error) {
+	if err := checkCardinalPoint(CardinalPoint(strings.TrimSpace(cardinalPoint)), North, South); err != nil {
+		return 0, nil, err
+	}
+
+	l, decimals, err := parseDMField(value, cardinalPoint)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	lat, err := l.Latitude()
+	return lat, decimals, err
+}
+
+// parseLongitudeField return longitude and number of decimals of minutes from the pair of data fields, NoCoordinate decimals if empty
+func parseLongitudeField(value, cardinalPoint string) (Longitude, *int, error) {
+	if err := checkCardinalPoint(CardinalPoint(strings.TrimSpace(cardinalPoint)), East, West); err != nil {
+		return 0, nil, err
+	}
+
+	l, decimals, err := parseDMField(value, cardinalPoint)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	long, err := l.Longitude()
+	return long, decimals, err
 }
@@ -2,9 +2,11 @@ package nmea
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 )
 
 type latlong struct {
@@ -52,7 +54,7 @@ func TestGPSCoordinate(t *testing.T) {
 			},
 			DMS: latlong{
 				Latitude:  "S 34° 36' 13.264\"",
-				Longitude: "W 58° 22' 53.612\"",
+				Longitude: "W 58° 22' 53.613\"",
 			},
 		},
 		{
@@ -74,7 +76,7 @@ func TestGPSCoordinate(t *testing.T) {
 			},
 			DMS: latlong{
 				Latitude:  "N 0° 34' 48.276\"",
-				Longitude: "E 9° 45' 21.093\"",
+				Longitude: "E 9° 45' 21.094\"",
 			},
 		},
 	}
@@ -113,3 +115,133 @@ func TestGPSCoordinate(t *testing.T) {
 		}
 	}
 }
+
+func TestDMField(t *testing.T) {
+	for _, test := range []struct {
+		value, cardinalPoint string
+		isLatitude           bool
+		decimals             int
+		expected             string
+	}{
+		{"3150.68378", "N", true, 5, "3150.68378"},
+		{"11711.93139", "E", false, 5, "11711.93139"},
+		{"00042.24", "W", false, 2, "00042.24"},
+		{"4807.038", "S", true, 3, "4807.038"},
+		{"0000.0000", "N", true, 4, "0000.0000"},
+		{"00000", "E", false, 0, "00000"},
+		{"", "", true, NoCoordinate, ""},
+	} {
+		l, decimals, err := parseDMField(test.value, test.cardinalPoint)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s %s\", err: %s", test.value, test.cardinalPoint, err.Error())
+		}
+		if decimals == nil || *decimals != test.decimals {
+			t.Fatalf("Wrong number of decimals for \"%s\" (got: %v, wanted: %d)", test.value, decimals, test.decimals)
+		}
+
+		fields := serializeDMField(l, test.isLatitude, decimals)
+		if fields[0] != test.expected || fields[1] != test.cardinalPoint {
+			t.Fatalf("Wrong serialization (got: %v, wanted: [%s %s])", fields, test.expected, test.cardinalPoint)
+		}
+	}
+
+	// Explicit precision when building a sentence
+	four := 4
+	gll := GPGLL{
+		Latitude:          Latitude(49.274166666666666),
+		Longitude:         Longitude(-123.18533333333335),
+		LatitudeDecimals:  &four,
+		LongitudeDecimals: &four,
+		TimeUTC:           time.Date(0, 1, 1, 22, 54, 44, 0, time.UTC),
+		IsValid:           Valid,
+		PositioningMode:   AutonomousGNSSFix,
+	}
+	if raw := gll.Serialize(); raw != "$GPGLL,4916.4500,N,12311.1200,W,225444,A,A*5C" {
+		t.Fatalf("Wrong serialization (got: %s)", raw)
+	}
+
+	// Precision not specified when building a sentence
+	gll.LatitudeDecimals, gll.LongitudeDecimals = nil, nil
+	if raw := gll.Serialize(); raw != "$GPGLL,4916.4500,N,12311.1200,W,225444,A,A*5C" {
+		t.Fatalf("Wrong serialization with default precision (got: %s)", raw)
+	}
+
+	// Whole minutes
+	zero := 0
+	gll.LatitudeDecimals, gll.LongitudeDecimals = &zero, &zero
+	if raw := gll.Serialize(); raw != "$GPGLL,4916,N,12311,W,225444,A,A*5E" {
+		t.Fatalf("Wrong serialization of whole minutes (got: %s)", raw)
+	}
+
+	// Backward compatible serialization with trailing zeros trimmed
+	if raw := LatLong(49.274166666666666).Serialize(); raw != "4916.45" {
+		t.Fatalf("Wrong serialization (got: %s)", raw)
+	}
+
+	// Minutes rounded up to 60 are carried to degrees
+	if raw := LatLong(45.9999999).SerializeDM(true, 4); raw != "4600.0000" {
+		t.Fatalf("Wrong carry of minutes (got: %s)", raw)
+	}
+}
+
+func TestLatitudeLongitude(t *testing.T) {
+	for raw, expected := range map[string]Latitude{
+		"49.274167 N": Latitude(49.274167),
+		"37.8608S":    Latitude(-37.8608),
+		"-34.6036844": Latitude(-34.6036844),
+		"90":          MaxLatitude,
+	} {
+		lat, err := NewLatitude(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse latitude \"%s\", err: %s", raw, err.Error())
+		}
+		if math.Abs(float64(lat-expected)) > 1e-9 {
+			t.Fatalf("Wrong latitude for \"%s\" (got: %f, wanted: %f)", raw, lat, expected)
+		}
+	}
+
+	// Plain numbers are decimal degrees, not ‘ddmm.mmmm’ like data fields
+	for _, invalid := range []string{"120", "-90.5", "49.27 E", "4916.45 N", "12311.12W"} {
+		if _, err := NewLatitude(invalid); err == nil {
+			t.Fatalf("Latitude \"%s\" should be rejected", invalid)
+		}
+	}
+
+	for raw, expected := range map[string]Longitude{
+		"123.185333 W": Longitude(-123.185333),
+		"0.704E":       Longitude(0.704),
+		"-180":         MinLongitude,
+	} {
+		long, err := NewLongitude(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse longitude \"%s\", err: %s", raw, err.Error())
+		}
+		if math.Abs(float64(long-expected)) > 1e-9 {
+			t.Fatalf("Wrong longitude for \"%s\" (got: %f, wanted: %f)", raw, long, expected)
+		}
+	}
+
+	for _, invalid := range []string{"180.1", "49.27 N", "12311.12 W"} {
+		if _, err := NewLongitude(invalid); err == nil {
+			t.Fatalf("Longitude \"%s\" should be rejected", invalid)
+		}
+	}
+
+	// Conversions from generic coordinate
+	if _, err := LatLong(120).Latitude(); err == nil {
+		t.Fatal("LatLong 120 shouldn't be a valid latitude")
+	}
+	if long, err := LatLong(120).Longitude(); err != nil || long.CardinalPoint() != East {
+		t.Fatalf("Wrong longitude conversion (got: %f, err: %v)", long, err)
+	}
+
+	// Hemisphere is checked in sentence data fields
+	for _, raw := range []string{
+		"$GPGLL,4916.45,E,12311.12,W,225444,A,A*57",
+		"$GPGLL,4916.45,N,12311.12,N,225444,A,A*45",
+	} {
+		if _, err := Parse(raw); err == nil {
+			t.Fatalf("\"%s\" should be rejected", raw)
+		}
+	}
+}
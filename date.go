@@ -0,0 +1,112 @@
+package nmea
+
+import "time"
+
+var (
+	// DefaultRolloverPivot is the last GPS week number rollover (2019-04-07)
+	DefaultRolloverPivot = time.Date(2019, time.April, 7, 0, 0, 0, 0, time.UTC)
+)
+
+// CorrectWeekRollover shift a date by 1024 weeks cycles until it isn't before pivot
+func CorrectWeekRollover(t, pivot time.Time) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	for t.Before(pivot) {
+		t = t.Add(GPSWeekRollover * GPSSecondsPerWeek * time.Second)
+	}
+	return t
+}
+
+// DateResolver attach the last known date (from RMC or ZDA sentences) to time-only sentences
+// (GGA, GLL) and correct dates affected by the GPS week number rollover
+type DateResolver struct {
+	Pivot time.Time // Dates before pivot are shifted by 1024 weeks cycles (DefaultRolloverPivot if zero)
+
+	last time.Time // Last resolved date time
+}
+
+func NewDateResolver(pivot time.Time) *DateResolver {
+	return &DateResolver{Pivot: pivot}
+}
+
+// Update resolve date time of the message and attach it to the message (RMC, ZDA, GGA and GLL),
+// return false if unable to resolve it (ie: invalid RMC or date not yet known by the receiver)
+func (r *DateResolver) Update(msg NMEA) (t time.Time, ok bool) {
+	switch m := msg.(type) {
+	case *GPRMC:
+		if !m.IsValid {
+			return // Date of an invalid fix is unreliable
+		}
+		t, ok = r.resolve(m.DateTimeUTC)
+		if ok {
+			m.DateTimeUTC = t
+		}
+	case *ZDA:
+		t, ok = r.resolve(m.DateTimeUTC)
+		if ok {
+			m.DateTimeUTC = t
+		}
+	case *GPGGA:
+		t, ok = r.Resolve(m.TimeUTC)
+		if ok {
+			m.TimeUTC = t
+		}
+	case *GPGLL:
+		t, ok = r.Resolve(m.TimeUTC)
+		if ok {
+			m.TimeUTC = t
+		}
+	}
+	return
+}
+
+// Resolve attach the last known date to a time of day, handling midnight crossing
+// (time of day more than 12 hours before or after the last resolved date time)
+func (r *DateResolver) Resolve(timeOfDay time.Time) (time.Time, bool) {
+	if r.last.IsZero() || timeOfDay.IsZero() {
+		return time.Time{}, false
+	}
+
+	y, mo, d := r.last.Date()
+	t := time.Date(y, mo, d, 0, 0, 0, 0, time.UTC).Add(timeOfDay.Sub(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	if delta := t.Sub(r.last); delta < -12*time.Hour {
+		t = t.AddDate(0, 0, 1)
+	} else if delta > 12*time.Hour {
+		t = t.AddDate(0, 0, -1)
+	}
+
+	r.last = t
+	return t, true
+}
+
+// Last return the last resolved date time, zero if none
+func (r *DateResolver) Last() time.Time {
+	return r.last
+}
+
+// resolve record date time from a dated sentence after week rollover correction,
+// fallback on the last known date when the sentence carry only a time of day
+func (r *DateResolver) resolve(t time.Time) (time.Time, bool) {
+	if t.IsZero() || isPlaceholderDate(t) {
+		return time.Time{}, false
+	}
+	if t.Year() == 0 { // Date not available
+		return r.Resolve(t)
+	}
+
+	pivot := r.Pivot
+	if pivot.IsZero() {
+		pivot = DefaultRolloverPivot
+	}
+
+	r.last = CorrectWeekRollover(t, pivot)
+	return r.last, true
+}
+
+// isPlaceholderDate return true for the GPS epoch date (060180) output by receivers before the date is known
+func isPlaceholderDate(t time.Time) bool {
+	y, m, d := t.Date()
+	return y == GPSEpoch.Year() && m == GPSEpoch.Month() && d == GPSEpoch.Day()
+}
@@ -0,0 +1,91 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateResolver(t *testing.T) {
+	resolver := NewDateResolver(time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC))
+
+	// Time-only sentence without known date
+	raw := "$GPGLL,3110.2908,N,12123.2348,E,235958.00,A,A*67"
+	msg, err := Parse(raw)
+	if err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+	}
+	if _, ok := resolver.Update(msg); ok {
+		t.Fatal("Date shouldn't be resolved without RMC or ZDA sentence")
+	}
+
+	for _, test := range []struct {
+		raw      string
+		expected time.Time
+	}{
+		{"$GPRMC,235959.00,A,3150.7238,N,11711.7278,E,0.00,0.00,311203,,,A*59", time.Date(2003, time.December, 31, 23, 59, 59, 0, time.UTC)},
+		{"$GPGGA,000001.00,3150.68378,N,11711.93139,E,1,17,0.6,0051.6,M,0.0,M,,*6C", time.Date(2004, time.January, 1, 0, 0, 1, 0, time.UTC)},
+		{"$GPGLL,3110.2908,N,12123.2348,E,235958.00,A,A*67", time.Date(2003, time.December, 31, 23, 59, 58, 0, time.UTC)},
+		{"$GPZDA,160012.71,11,03,2004,-01,00*4D", time.Date(2004, time.March, 11, 16, 0, 12, 710000000, time.UTC)},
+	} {
+		msg, err := Parse(test.raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", test.raw, err.Error())
+		}
+
+		resolved, ok := resolver.Update(msg)
+		if !ok || !resolved.Equal(test.expected) {
+			t.Fatalf("Wrong resolved date for \"%s\" (got: %s, wanted: %s)", test.raw, resolved, test.expected)
+		}
+		if out := msg.Serialize(); out != test.raw {
+			t.Fatalf("Wrong serialization (got: %s, wanted: %s)", out, test.raw)
+		}
+	}
+}
+
+func TestWeekRollover(t *testing.T) {
+	resolver := NewDateResolver(time.Time{}) // Default pivot
+
+	raw := "$GPRMC,123519,A,4807.038,N,01131.000,E,022.4,084.4,010999,,,A*7B"
+	msg, err := Parse(raw)
+	if err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+	}
+
+	expected := time.Date(1999, time.September, 1, 12, 35, 19, 0, time.UTC).AddDate(0, 0, 1024*7)
+	if resolved, ok := resolver.Update(msg); !ok || !resolved.Equal(expected) || !msg.(*GPRMC).DateTimeUTC.Equal(expected) {
+		t.Fatalf("Wrong corrected date (got: %s, wanted: %s)", resolved, expected)
+	}
+
+}
+
+func TestDateResolverUnknownDate(t *testing.T) {
+	resolver := NewDateResolver(time.Time{}) // Default pivot
+
+	// Date placeholder (GPS epoch) and invalid fix output by receivers before the date is known are ignored
+	for _, raw := range []string{
+		"$GPZDA,000012.00,06,01,1980,00,00*62",
+		"$GPRMC,000012.00,A,0000.0000,N,00000.0000,E,0.00,0.00,060180,,,A*52",
+		"$GPRMC,123520,V,4807.038,N,01131.000,E,022.4,084.4,020999,,,N*6A",
+	} {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		if resolved, ok := resolver.Update(msg); ok {
+			t.Fatalf("Date of \"%s\" shouldn't be resolved (got: %s)", raw, resolved)
+		}
+		if !resolver.Last().IsZero() {
+			t.Fatalf("Last date shouldn't be updated by \"%s\" (got: %s)", raw, resolver.Last())
+		}
+	}
+
+	// Date without time of day keeps time data field empty
+	raw := "$GPZDA,,11,03,2004,00,00*4D"
+	msg, err := Parse(raw)
+	if err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+	}
+	if out := msg.Serialize(); out != raw {
+		t.Fatalf("Wrong serialization (got: %s, wanted: %s)", out, raw)
+	}
+}
@@ -0,0 +1,39 @@
+package nmea
+
+import "fmt"
+
+// Examples:
+// $SDDBS,9.4,f,2.9,M,1.6,F*00
+
+func NewDBS(m Message) *DBS {
+	return &DBS{Message: m}
+}
+
+// DBS is the water depth referenced to the water surface
+type DBS struct {
+	Message
+	Depth
+}
+
+func (m *DBS) parse() (err error) {
+	if len(m.Fields) != 6 {
+		return m.Error(fmt.Errorf("Incomplete DBS message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 6))
+	}
+
+	if err = m.Depth.parse(m.Fields); err != nil {
+		return m.Error(err)
+	}
+
+	return nil
+}
+
+func (m DBS) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("DBS")
+	fields := m.Depth.serialize(m.Message)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,101 @@
+package nmea
+
+import "fmt"
+
+// Examples:
+// $SDDBT,7.8,f,2.4,M,1.3,F*0D
+// $SDDBT,,f,,M,,F*28
+
+func NewDBT(m Message) *DBT {
+	return &DBT{Message: m}
+}
+
+// DBT is the water depth referenced to the transducer
+type DBT struct {
+	Message
+	Depth
+}
+
+func (m *DBT) parse() (err error) {
+	if len(m.Fields) != 6 {
+		return m.Error(fmt.Errorf("Incomplete DBT message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 6))
+	}
+
+	if err = m.Depth.parse(m.Fields); err != nil {
+		return m.Error(err)
+	}
+
+	return nil
+}
+
+func (m DBT) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("DBT")
+	fields := m.Depth.serialize(m.Message)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// Depth is a depth measurement as provided by depth sounders (same value in feet, meters and fathoms)
+type Depth struct {
+	Feet    *float64 // Depth in feet, empty if not available
+	Meters  *float64 // Depth in meters, empty if not available
+	Fathoms *float64 // Depth in fathoms, empty if not available
+}
+
+func (d *Depth) parse(fields []string) (err error) {
+	// Validate fixed field
+	for i, v := range map[int]DepthUnit{1: DepthUnitFeet, 3: DepthUnitMeters, 5: DepthUnitFathoms} {
+		if fields[i] != v.Serialize() {
+			return fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, fields[i], v)
+		}
+	}
+
+	if d.Feet, err = parseOptionalFloat(fields[0]); err != nil {
+		return fmt.Errorf("Unable to parse depth in feet from data field (got: %s)", fields[0])
+	}
+
+	if d.Meters, err = parseOptionalFloat(fields[2]); err != nil {
+		return fmt.Errorf("Unable to parse depth in meters from data field (got: %s)", fields[2])
+	}
+
+	if d.Fathoms, err = parseOptionalFloat(fields[4]); err != nil {
+		return fmt.Errorf("Unable to parse depth in fathoms from data field (got: %s)", fields[4])
+	}
+
+	return nil
+}
+
+// serialize return data fields of depth with precision of the data fields of parsed message (see formatFloat)
+func (d Depth) serialize(m Message) []string {
+	return []string{
+		formatOptionalFloat(d.Feet, m.field(0), "%.1f"), DepthUnitFeet.Serialize(),
+		formatOptionalFloat(d.Meters, m.field(2), "%.1f"), DepthUnitMeters.Serialize(),
+		formatOptionalFloat(d.Fathoms, m.field(4), "%.1f"), DepthUnitFathoms.Serialize(),
+	}
+}
+
+// InMeters return depth normalised in meters from the first available unit (meters, feet then fathoms)
+func (d Depth) InMeters() *float64 {
+	var v float64
+	switch {
+	case d.Meters != nil:
+		v = *d.Meters
+	case d.Feet != nil:
+		v = DepthUnitFeet.ToMeters(*d.Feet)
+	case d.Fathoms != nil:
+		v = DepthUnitFathoms.ToMeters(*d.Fathoms)
+	default:
+		return nil
+	}
+	return &v
+}
+
+// NewDepth return depth expressed in all units from a value in meters
+func NewDepth(meters float64) Depth {
+	feet, fathoms := DepthUnitFeet.FromMeters(meters), DepthUnitFathoms.FromMeters(meters)
+	return Depth{Feet: &feet, Meters: &meters, Fathoms: &fathoms}
+}
@@ -0,0 +1,47 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+)
+
+func TestDepthNormalisation(t *testing.T) {
+	feet := 7.8
+	if meters := (Depth{Feet: &feet}).InMeters(); meters == nil || math.Abs(*meters-2.37744) > 1e-9 {
+		t.Fatalf("Wrong depth conversion from feet (got: %v)", meters)
+	}
+
+	fathoms := 1.3
+	if meters := (Depth{Fathoms: &fathoms}).InMeters(); meters == nil || math.Abs(*meters-2.37744) > 1e-9 {
+		t.Fatalf("Wrong depth conversion from fathoms (got: %v)", meters)
+	}
+
+	if meters := (Depth{}).InMeters(); meters != nil {
+		t.Fatalf("Depth should be unknown (got: %f)", *meters)
+	}
+
+	d := NewDepth(2.4)
+	if math.Abs(*d.Feet-7.874015748) > 1e-6 || math.Abs(*d.Fathoms-1.312335958) > 1e-6 {
+		t.Fatalf("Wrong depth conversion from meters (got: %f feet, %f fathoms)", *d.Feet, *d.Fathoms)
+	}
+
+	msg, err := Parse("$SDDPT,12.6,-1.2,100.0*4F")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dpt := msg.(*DPT)
+	if keel := dpt.DepthBelowKeel(); keel == nil || math.Abs(*keel-11.4) > 1e-9 {
+		t.Fatalf("Wrong depth below keel (got: %v)", keel)
+	}
+	if dpt.DepthBelowSurface() != nil {
+		t.Fatal("Depth below surface should be unknown when offset is relative to the keel")
+	}
+
+	if msg, err = Parse("$SDDPT,,,*7B"); err != nil {
+		t.Fatal(err)
+	}
+	if dpt = msg.(*DPT); dpt.Depth != nil || dpt.DepthBelowKeel() != nil || dpt.DepthBelowSurface() != nil {
+		t.Fatalf("Depth should be unknown (got: %v)", dpt.Depth)
+	}
+}
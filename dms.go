@@ -0,0 +1,171 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+const (
+	// Human readable coordinate formats
+	DD  CoordinateFormat = iota // Decimal degrees, ie: 40.712775° N
+	DDM                         // Degrees and decimal minutes, ie: 40° 42.7665' N
+	DMS                         // Degrees, minutes and seconds, ie: 40° 42' 45.991" N
+)
+
+var (
+	// Symbols allowed after each component of a coordinate (unicode and ASCII)
+	degreeSymbols = []string{"°", "º", "˚", "d"}
+	minuteSymbols = []string{"′", "’", "'"}
+	secondSymbols = []string{"″", "”", "''", "\""}
+)
+
+type CoordinateFormat int
+
+func (f CoordinateFormat) String() string {
+	switch f {
+	case DD:
+		return "DD"
+	case DDM:
+		return "DDM"
+	case DMS:
+		return "DMS"
+	default:
+		return "unknow"
+	}
+}
+
+// ParseCoordinate parses human readable coordinate as decimal degrees (DD), degrees and decimal minutes (DDM)
+// or degrees, minutes and seconds (DMS), with unicode or ASCII symbols, signed or with a hemisphere as prefix or suffix
+//
+// Allowed format, ie:
+// - "40.7127753", "-74.0059728", "40.7127753° N"
+// - "N 40° 42.7665'", "40 42.7665 N"
+// - "N 40° 42' 45.991\"", "40°42′45.991″N", "74d0'21.502\"W", "-74 0 21.502" (seconds symbol could also be two single quotes)
+//
+// The hemisphere is returned as parsed (empty if signed) to check the kind of coordinate (see NewLatitude)
+func ParseCoordinate(raw string) (l LatLong, hemisphere CardinalPoint, err error) {
+	value := strings.TrimSpace(raw)
+	if len(value) == 0 {
+		return 0, "", fmt.Errorf("Invalid coordinate, can't be empty")
+	}
+
+	// Extract hemisphere from prefix or suffix
+	if cp, e := ParseCardinalPoint(strings.ToUpper(value[:1])); e == nil {
+		hemisphere, value = cp, value[1:]
+	}
+	if value = strings.TrimSpace(value); len(value) > 0 {
+		if cp, e := ParseCardinalPoint(strings.ToUpper(value[len(value)-1:])); e == nil {
+			if len(hemisphere) > 0 {
+				return 0, "", fmt.Errorf("Wrong coordinate format, hemisphere both as prefix and suffix (got: \"%s\")", raw)
+			}
+			hemisphere, value = cp, value[:len(value)-1]
+		}
+	}
+
+	// Extract sign
+	value = strings.TrimSpace(value)
+	negative := strings.HasPrefix(value, "-")
+	value = strings.TrimPrefix(strings.TrimPrefix(value, "-"), "+")
+	if negative && len(hemisphere) > 0 {
+		return 0, "", fmt.Errorf("Wrong coordinate format, both signed and with hemisphere (got: \"%s\")", raw)
+	}
+
+	// Split components by symbols (seconds first because of ASCII '' symbol)
+	for _, symbols := range [][]string{secondSymbols, minuteSymbols, degreeSymbols} {
+		for _, symbol := range symbols {
+			value = strings.ReplaceAll(value, symbol, " ")
+		}
+	}
+
+	components := strings.Fields(value)
+	if len(components) == 0 || len(components) > 3 {
+		return 0, "", fmt.Errorf("Wrong coordinate format (got: \"%s\")", raw)
+	}
+
+	var degrees float64
+	for i, component := range components {
+		v, err := strconv.ParseFloat(component, 64)
+		if err != nil || v < 0 {
+			return 0, "", fmt.Errorf("Wrong coordinate format (got: \"%s\")", raw)
+		}
+
+		// Only the last component could have decimals, minutes and seconds are lower than 60
+		if i < len(components)-1 && v != math.Trunc(v) {
+			return 0, "", fmt.Errorf("Wrong coordinate format, only last component could have decimals (got: \"%s\")", raw)
+		}
+		if i > 0 && v >= 60 {
+			return 0, "", fmt.Errorf("Wrong coordinate format, minutes and seconds should be lower than 60 (got: \"%s\")", raw)
+		}
+
+		degrees += v / math.Pow(60, float64(i))
+	}
+
+	switch hemisphere {
+	case South, West:
+		negative = true
+	}
+	if negative {
+		degrees = 0 - degrees
+	}
+
+	if l = LatLong(degrees); l < Min || l > Max {
+		err = fmt.Errorf("invalid range (got: %f)", l)
+	}
+	return
+}
+
+// Format return human readable coordinate with hemisphere suffix (North/South for latitude, East/West for longitude)
+// and expected number of decimals for the last component, rounding carries to upper components (never 60")
+func (l LatLong) Format(format CoordinateFormat, isLatitude bool, precision int) string {
+	hemisphere := l.roundedCardinalPoint(isLatitude, format, precision)
+	if len(hemisphere) == 0 {
+		if hemisphere = East; isLatitude {
+			hemisphere = North
+		}
+	}
+
+	return l.format(format, precision) + " " + hemisphere.String()
+}
+
+// roundedCardinalPoint return cardinal point of the coordinate once rounded, empty if rounded to zero
+// (ie: a tiny negative coordinate isn't South or West)
+func (l LatLong) roundedCardinalPoint(isLatitude bool, format CoordinateFormat, precision int) CardinalPoint {
+	if l.round(format, precision) == 0 {
+		return ""
+	}
+	return l.CardinalPoint(isLatitude)
+}
+
+// round return unsigned coordinate rounded in unit of the last component of format with expected number of decimals
+// (ie: hundredths of seconds for DMS with precision 2)
+func (l LatLong) round(format CoordinateFormat, precision int) float64 {
+	abs := math.Abs(float64(l)) * math.Pow(10, float64(precision))
+	switch format {
+	case DDM:
+		return math.Round(abs * 60)
+	case DMS:
+		return math.Round(abs * 3600)
+	default:
+		return math.Round(abs)
+	}
+}
+
+// format return unsigned human readable coordinate without hemisphere
+func (l LatLong) format(format CoordinateFormat, precision int) string {
+	pow := math.Pow(10, float64(precision))
+	total := l.round(format, precision)
+
+	switch format {
+	case DDM:
+		d, m := math.Floor(total/(60*pow)), math.Mod(total, 60*pow)/pow
+		return fmt.Sprintf("%.0f° %.*f'", d, precision, m)
+	case DMS:
+		d, rest := math.Floor(total/(3600*pow)), math.Mod(total, 3600*pow)
+		m, s := math.Floor(rest/(60*pow)), math.Mod(rest, 60*pow)/pow
+		return fmt.Sprintf("%.0f° %.0f' %.*f\"", d, m, precision, s)
+	default:
+		return fmt.Sprintf("%.*f°", precision, total/pow)
+	}
+}
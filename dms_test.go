@@ -0,0 +1,147 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+)
+
+func TestParseCoordinate(t *testing.T) {
+	for raw, expected := range map[string]float64{
+		// DD
+		"40.7127753":    40.7127753,
+		"-74.0059728":   -74.0059728,
+		"+4.835659":     4.835659,
+		"40.7127753° N": 40.7127753,
+		"S 34.6036844°": -34.6036844,
+		"174.7633315°e": 174.7633315,
+		// DDM
+		"N 40° 42.7665'": 40 + 42.7665/60,
+		"40 42.7665 N":   40 + 42.7665/60,
+		"W 074°00.3584′": -(74 + 0.3584/60),
+		// DMS
+		"N 40° 42' 45.991\"":  40 + 42.0/60 + 45.991/3600,
+		"40°42′45.991″N":      40 + 42.0/60 + 45.991/3600,
+		"74d0'21.502''W":      -(74 + 21.502/3600),
+		"-74 0 21.502":        -(74 + 21.502/3600),
+		"S 36º 50’ 54.455”":   -(36 + 50.0/60 + 54.455/3600),
+		"E 174˚ 45' 47.993\"": 174 + 45.0/60 + 47.993/3600,
+	} {
+		l, _, err := ParseCoordinate(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		if math.Abs(float64(l)-expected) > 1e-9 {
+			t.Fatalf("Wrong coordinate for \"%s\" (got: %f, wanted: %f)", raw, l, expected)
+		}
+	}
+
+	for _, invalid := range []string{
+		"",
+		"N",
+		"N 40° 42' 45.991\" N", // Hemisphere twice
+		"-40.5 S",              // Signed with hemisphere
+		"40.5° 42'",            // Decimals before last component
+		"40° 60' 10\"",         // Minutes out of range
+		"40° 42' 60\"",         // Seconds out of range
+		"40 42 45 10",          // Too many components
+		"181° E",               // Out of range
+		"40° 42x",
+	} {
+		if _, _, err := ParseCoordinate(invalid); err == nil {
+			t.Fatalf("Coordinate \"%s\" should be rejected", invalid)
+		}
+	}
+
+	// Hemisphere as prefix or suffix is returned, empty if signed
+	for raw, expected := range map[string]CardinalPoint{"W 074°00.3584′": West, "40°42′45.991″N": North, "-74 0 21.502": ""} {
+		if _, hemisphere, err := ParseCoordinate(raw); err != nil || hemisphere != expected {
+			t.Fatalf("Wrong hemisphere of \"%s\" (got: %s, wanted: %s, err: %v)", raw, hemisphere, expected, err)
+		}
+	}
+
+	// Hemisphere should match the kind of coordinate
+	for _, invalid := range []string{"W 40.5", "E 31° 50' 12\"", "3150.7238 E"} {
+		if _, err := NewLatitude(invalid); err == nil {
+			t.Fatalf("Latitude \"%s\" should be rejected", invalid)
+		}
+	}
+	for _, invalid := range []string{"N 40.5", "74d0'21.502\"S", "12309.57 N"} {
+		if _, err := NewLongitude(invalid); err == nil {
+			t.Fatalf("Longitude \"%s\" should be rejected", invalid)
+		}
+	}
+	if l, err := NewLatitude("S 34.6036844"); err != nil || l != -34.6036844 {
+		t.Fatalf("Wrong latitude (got: %f, err: %v)", l, err)
+	}
+
+	// Plain numbers with hemisphere are decimal degrees
+	for raw, expected := range map[string]LatLong{
+		"40.7127753 N": 40.7127753,
+		"12.5E":        12.5,
+		"40 N":         40,
+		"74.0059728 W": -74.0059728,
+		"S 34.6036844": -34.6036844,
+		"W 123.185333": -123.185333,
+	} {
+		if l, err := NewLatLong(raw); err != nil || math.Abs(float64(l-expected)) > 1e-9 {
+			t.Fatalf("Wrong coordinate for \"%s\" (got: %f, wanted: %f, err: %v)", raw, l, expected, err)
+		}
+	}
+
+	// NewLatLong fallback on human readable formats
+	if l, err := NewLatLong("N 31° 50' 43.428\""); err != nil || math.Abs(float64(l)-(31+50.0/60+43.428/3600)) > 1e-9 {
+		t.Fatalf("Wrong coordinate (got: %f, err: %v)", l, err)
+	}
+}
+
+func TestFormatCoordinate(t *testing.T) {
+	for _, test := range []struct {
+		value      LatLong
+		isLatitude bool
+		format     CoordinateFormat
+		precision  int
+		expected   string
+	}{
+		{LatLong(40.7127753), true, DD, 6, "40.712775° N"},
+		{LatLong(-74.0059728), false, DD, 4, "74.0060° W"},
+		{LatLong(40.7127753), true, DDM, 4, "40° 42.7665' N"},
+		{LatLong(-74.0059728), false, DMS, 3, "74° 0' 21.502\" W"},
+		{LatLong(-58.381559100000004), false, DMS, 3, "58° 22' 53.613\" W"},
+		{LatLong(0), true, DMS, 1, "0° 0' 0.0\" N"},
+		// Rounding carried to minutes and degrees
+		{LatLong(45.99999999), true, DMS, 3, "46° 0' 0.000\" N"},
+		{LatLong(-10 - 59.9999999/60), false, DDM, 3, "11° 0.000' W"},
+		{LatLong(12.5 + 59.9996/3600), true, DMS, 3, "12° 31' 0.000\" N"},
+		{LatLong(12.5 + 59.9996/3600), true, DMS, 4, "12° 30' 59.9996\" N"},
+	} {
+		if got := test.value.Format(test.format, test.isLatitude, test.precision); got != test.expected {
+			t.Fatalf("Wrong %s format (got: %s, wanted: %s)", test.format, got, test.expected)
+		}
+
+		// Formatted coordinate could be parsed back
+		l, _, err := ParseCoordinate(test.expected)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", test.expected, err.Error())
+		}
+		if tolerance := 1.0 / math.Pow(10, float64(test.precision)); math.Abs(float64(l-test.value)) > tolerance {
+			t.Fatalf("Wrong parsed coordinate for \"%s\" (got: %f, wanted: %f)", test.expected, l, test.value)
+		}
+	}
+
+	if got := Latitude(-34.60368440000001).Format(DMS, 3); got != "34° 36' 13.264\" S" {
+		t.Fatalf("Wrong latitude format (got: %s)", got)
+	}
+
+	// Hemisphere of the rounded value
+	if got := Latitude(-1e-9).Format(DMS, 3); got != "0° 0' 0.000\" N" {
+		t.Fatalf("Tiny negative latitude shouldn't be South (got: %s)", got)
+	}
+	if got := Longitude(-1e-9).ToDMS(); got != "0° 0' 0.000\" E" {
+		t.Fatalf("Tiny negative longitude shouldn't be West (got: %s)", got)
+	}
+
+	// Rounding carried like Format
+	if got := LatLong(59.99999999).ToDMS(); got != "60° 0' 0.000\"" {
+		t.Fatalf("Wrong DMS rounding (got: %s)", got)
+	}
+}
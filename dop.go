@@ -0,0 +1,211 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+)
+
+// DOP is the dilution of precision of a satellite geometry
+type DOP struct {
+	GDOP float64 // Geometric
+	PDOP float64 // Position (3D)
+	HDOP float64 // Horizontal
+	VDOP float64 // Vertical
+	TDOP float64 // Time (receiver clock of first constellation when each constellation has its own clock)
+
+	TDOPs map[Constellation]float64 // Time of receiver clock solved for satellites of each constellation
+}
+
+// SatelliteDirection is the direction in sky of a satellite from receiver
+type SatelliteDirection struct {
+	ID        SatelliteID
+	Elevation float64 // Elevation in degree (0 ~ 90)
+	Azimuth   float64 // Azimuth in degree (0 ~ 359)
+}
+
+// SatelliteDirections return direction of satellites used in solution (GSA) from satellites in view (GSV) numbered
+// according to version of the standard (see NewSatelliteID), error if a used satellite is not in view or without
+// elevation and azimuth
+func SatelliteDirections(inView []GPGSV, used []GPGSA, version Version) ([]SatelliteDirection, error) {
+	directions := make(map[string]SatelliteDirection)
+	for _, gsv := range inView {
+		ids, err := gsv.SatelliteIDs(version)
+		if err != nil {
+			return nil, err
+		}
+		for i, s := range gsv.Satellites {
+			if s.Elevation == nil || s.Azimuth == nil {
+				continue
+			}
+			directions[ids[i].String()] = SatelliteDirection{ID: ids[i], Elevation: float64(*s.Elevation), Azimuth: float64(*s.Azimuth)}
+		}
+	}
+
+	satellites := make([]SatelliteDirection, 0)
+	for _, gsa := range used {
+		ids, err := gsa.SatellitesUsed(version)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range ids {
+			d, ok := directions[id.String()]
+			if !ok {
+				return nil, fmt.Errorf("Satellite %s used without elevation and azimuth in view", id)
+			}
+			satellites = append(satellites, d)
+		}
+	}
+	return satellites, nil
+}
+
+// WithoutSatellites return directions of satellites except excluded ones (ie: to compute DOP if they were not used)
+func WithoutSatellites(satellites []SatelliteDirection, excluded ...SatelliteID) []SatelliteDirection {
+	filtered := make([]SatelliteDirection, 0, len(satellites))
+	for _, s := range satellites {
+		keep := true
+		for _, id := range excluded {
+			if s.ID.String() == id.String() {
+				keep = false
+				break
+			}
+		}
+		if keep {
+			filtered = append(filtered, s)
+		}
+	}
+	return filtered
+}
+
+// ComputeDOP return dilution of precision of satellites used in solution from the geometry matrix, with a receiver
+// clock term for each constellation when clockPerConstellation is set (multi-constellation solution) or a common one,
+// error if there is not enougth satellites or geometry is degenerated
+func ComputeDOP(satellites []SatelliteDirection, clockPerConstellation bool) (DOP, error) {
+	// Clock column of each constellation in order of appearance
+	clocks := map[Constellation]int{}
+	for _, s := range satellites {
+		if _, ok := clocks[s.ID.Constellation]; !ok && (clockPerConstellation || len(clocks) == 0) {
+			clocks[s.ID.Constellation] = 3 + len(clocks)
+		}
+	}
+
+	unknowns := 3 + len(clocks)
+	if len(satellites) < unknowns {
+		return DOP{}, fmt.Errorf("Not enougth satellites to compute DOP (got: %d, wanted: %d)", len(satellites), unknowns)
+	}
+
+	// Normal matrix (transpose(G) * G) of line of sight unit vectors (east, north, up) and clock terms
+	normal := make([][]float64, unknowns)
+	for i := range normal {
+		normal[i] = make([]float64, unknowns)
+	}
+	for _, s := range satellites {
+		sinEl, cosEl := math.Sincos(s.Elevation * math.Pi / 180)
+		sinAz, cosAz := math.Sincos(s.Azimuth * math.Pi / 180)
+
+		row := make([]float64, unknowns)
+		row[0], row[1], row[2] = -cosEl*sinAz, -cosEl*cosAz, -sinEl
+		clock, ok := clocks[s.ID.Constellation]
+		if !ok {
+			clock = 3
+		}
+		row[clock] = 1
+
+		for i := range row {
+			for j := range row {
+				normal[i][j] += row[i] * row[j]
+			}
+		}
+	}
+
+	cofactor, err := invertMatrix(normal)
+	if err != nil {
+		return DOP{}, err
+	}
+
+	// Geometric DOP accounts for all unknowns (trace of cofactor matrix), including every receiver clock
+	trace := 0.0
+	for i := range cofactor {
+		trace += cofactor[i][i]
+	}
+
+	d := DOP{
+		GDOP:  math.Sqrt(trace),
+		HDOP:  math.Sqrt(cofactor[0][0] + cofactor[1][1]),
+		VDOP:  math.Sqrt(cofactor[2][2]),
+		PDOP:  math.Sqrt(cofactor[0][0] + cofactor[1][1] + cofactor[2][2]),
+		TDOP:  math.Sqrt(cofactor[3][3]),
+		TDOPs: map[Constellation]float64{},
+	}
+	for _, s := range satellites {
+		clock, ok := clocks[s.ID.Constellation]
+		if !ok {
+			clock = 3
+		}
+		d.TDOPs[s.ID.Constellation] = math.Sqrt(cofactor[clock][clock])
+	}
+	return d, nil
+}
+
+// CheckDOP return error when PDOP, HDOP or VDOP output by the receiver differs from computed DOP by more than tolerance
+func (m GPGSA) CheckDOP(d DOP, tolerance float64) error {
+	for _, c := range []struct {
+		name          string
+		got, expected float64
+	}{
+		{"PDOP", m.PDOP, d.PDOP},
+		{"HDOP", m.HDOP, d.HDOP},
+		{"VDOP", m.VDOP, d.VDOP},
+	} {
+		if math.Abs(c.got-c.expected) > tolerance {
+			return fmt.Errorf("%s mismatch (got: %.2f, expected: %.2f)", c.name, c.got, c.expected)
+		}
+	}
+	return nil
+}
+
+// invertMatrix return inverse of square matrix using Gauss-Jordan elimination with partial pivoting,
+// error if matrix is singular
+func invertMatrix(a [][]float64) ([][]float64, error) {
+	n := len(a)
+
+	// Augmented matrix [a | identity]
+	m := make([][]float64, n)
+	for i := range m {
+		m[i] = make([]float64, 2*n)
+		copy(m[i], a[i])
+		m[i][n+i] = 1
+	}
+
+	for col := 0; col < n; col++ {
+		pivot := col
+		for row := col + 1; row < n; row++ {
+			if math.Abs(m[row][col]) > math.Abs(m[pivot][col]) {
+				pivot = row
+			}
+		}
+		if math.Abs(m[pivot][col]) < 1e-12 {
+			return nil, fmt.Errorf("Degenerated satellite geometry, unable to compute DOP")
+		}
+		m[col], m[pivot] = m[pivot], m[col]
+
+		p := m[col][col]
+		for j := range m[col] {
+			m[col][j] /= p
+		}
+		for row := 0; row < n; row++ {
+			if row == col || m[row][col] == 0 {
+				continue
+			}
+			f := m[row][col]
+			for j := range m[row] {
+				m[row][j] -= f * m[col][j]
+			}
+		}
+	}
+
+	inverse := make([][]float64, n)
+	for i := range inverse {
+		inverse[i] = m[i][n:]
+	}
+	return inverse, nil
+}
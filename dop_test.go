@@ -0,0 +1,144 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+)
+
+func TestComputeDOP(t *testing.T) {
+	gps := func(prn int) SatelliteID {
+		id, _ := NewSatelliteID(prn, TalkerIDGPS, LatestVersion)
+		return id
+	}
+	glonass := func(slot int) SatelliteID {
+		id, _ := NewSatelliteID(slot+64, TalkerIDGL, LatestVersion)
+		return id
+	}
+
+	// Three satellites on horizon 120 degree apart and one at zenith (analytic cofactor matrix)
+	satellites := []SatelliteDirection{
+		{ID: gps(1), Elevation: 0, Azimuth: 0},
+		{ID: gps(2), Elevation: 0, Azimuth: 120},
+		{ID: gps(3), Elevation: 0, Azimuth: 240},
+		{ID: gps(4), Elevation: 90, Azimuth: 0},
+	}
+
+	d, err := ComputeDOP(satellites, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := DOP{GDOP: math.Sqrt(3), PDOP: math.Sqrt(8.0 / 3), HDOP: math.Sqrt(4.0 / 3), VDOP: math.Sqrt(4.0 / 3), TDOP: math.Sqrt(1.0 / 3)}
+	if !equalDOP(d, expected, 1e-9) {
+		t.Fatalf("Wrong DOP (got: %+v, wanted: %+v)", d, expected)
+	}
+
+	if d.TDOPs[ConstellationGPS] != d.TDOP || len(d.TDOPs) != 1 {
+		t.Fatalf("Wrong TDOP of constellations (got: %v)", d.TDOPs)
+	}
+
+	// A single satellite of another constellation only solves its own receiver clock: position and GPS clock
+	// are unchanged, GLONASS clock variance is 1 + transpose(g) * Q * g = 1 + (2/3 + 4/3) / 2 = 2 and
+	// GDOP is sqrt(8/3 + 1/3 + 2)
+	multi, err := ComputeDOP(append(satellites, SatelliteDirection{ID: glonass(1), Elevation: 45, Azimuth: 90}), true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedMulti := expected
+	expectedMulti.GDOP = math.Sqrt(5)
+	if !equalDOP(multi, expectedMulti, 1e-9) {
+		t.Fatalf("Wrong multi-constellation DOP (got: %+v, wanted: %+v)", multi, expectedMulti)
+	}
+	if math.Abs(multi.TDOPs[ConstellationGPS]-math.Sqrt(1.0/3)) > 1e-9 || math.Abs(multi.TDOPs[ConstellationGLONASS]-math.Sqrt(2)) > 1e-9 {
+		t.Fatalf("Wrong TDOP of constellations (got: %v)", multi.TDOPs)
+	}
+
+	// ... while it improves geometry with a common receiver clock
+	single, err := ComputeDOP(append(satellites, SatelliteDirection{ID: glonass(1), Elevation: 45, Azimuth: 90}), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if single.PDOP >= expected.PDOP {
+		t.Fatalf("PDOP should be improved with a common receiver clock (got: %f, wanted lower than: %f)", single.PDOP, expected.PDOP)
+	}
+	if single.TDOPs[ConstellationGLONASS] != single.TDOP || single.TDOPs[ConstellationGPS] != single.TDOP {
+		t.Fatalf("Constellations should share TDOP of common receiver clock (got: %v)", single.TDOPs)
+	}
+
+	if _, err := ComputeDOP(WithoutSatellites(satellites, gps(4)), false); err == nil {
+		t.Fatal("DOP shouldn't be computed with 3 satellites")
+	}
+
+	degenerated := []SatelliteDirection{
+		{ID: gps(1), Elevation: 30, Azimuth: 10},
+		{ID: gps(2), Elevation: 30, Azimuth: 10},
+		{ID: gps(3), Elevation: 30, Azimuth: 10},
+		{ID: gps(4), Elevation: 30, Azimuth: 10},
+	}
+	if _, err := ComputeDOP(degenerated, false); err == nil {
+		t.Fatal("DOP shouldn't be computed with degenerated geometry")
+	}
+
+	// Satellites in view and used from receiver output
+	var inView []GPGSV
+	for _, raw := range []string{
+		"$GPGSV,3,1,12,01,05,060,18,02,17,259,43,04,56,287,28,09,08,277,28*77",
+		"$GPGSV,3,2,12,10,34,195,46,13,08,125,45,17,67,014,,20,32,048,24*74",
+		"$GPGSV,3,3,12,23,13,094,48,24,04,292,24,28,49,178,46,32,06,037,22*7D",
+	} {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		inView = append(inView, *msg.(*GPGSV))
+	}
+
+	raw := "$GPGSA,A,3,04,10,17,20,28,13,23,,,,,,3.58,1.85,3.07*0C"
+	msg, err := Parse(raw)
+	if err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+	}
+	gsa := msg.(*GPGSA)
+
+	used, err := SatelliteDirections(inView, []GPGSA{*gsa}, LatestVersion)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(used) != 7 || used[0].ID.String() != "G04" || used[0].Elevation != 56 || used[0].Azimuth != 287 {
+		t.Fatalf("Wrong satellites used (got: %+v)", used)
+	}
+
+	if d, err = ComputeDOP(used, false); err != nil {
+		t.Fatal(err)
+	}
+	expected = DOP{GDOP: 4.214650, PDOP: 3.582680, HDOP: 1.853859, VDOP: 3.065747, TDOP: 2.219839}
+	if !equalDOP(d, expected, 1e-6) {
+		t.Fatalf("Wrong DOP (got: %+v, wanted: %+v)", d, expected)
+	}
+	if err := gsa.CheckDOP(d, 0.01); err != nil {
+		t.Fatalf("Reported DOP should match, err: %s", err.Error())
+	}
+
+	// Excluding low satellites degrades geometry
+	without, err := ComputeDOP(WithoutSatellites(used, gps(13), gps(23)), false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if without.PDOP <= d.PDOP {
+		t.Fatalf("PDOP should be degraded without low satellites (got: %f, wanted greater than: %f)", without.PDOP, d.PDOP)
+	}
+	if err := gsa.CheckDOP(without, 0.01); err == nil {
+		t.Fatal("DOP mismatch should be reported")
+	}
+
+	// Satellite used without direction in view
+	gsa.SatelliteUsedOnChannel[8] = 6
+	if _, err := SatelliteDirections(inView, []GPGSA{*gsa}, LatestVersion); err == nil {
+		t.Fatal("Satellite used but not in view should be reported")
+	}
+}
+
+func equalDOP(a, b DOP, tolerance float64) bool {
+	return math.Abs(a.GDOP-b.GDOP) <= tolerance && math.Abs(a.PDOP-b.PDOP) <= tolerance &&
+		math.Abs(a.HDOP-b.HDOP) <= tolerance && math.Abs(a.VDOP-b.VDOP) <= tolerance && math.Abs(a.TDOP-b.TDOP) <= tolerance
+}
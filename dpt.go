@@ -0,0 +1,90 @@
+package nmea
+
+import "fmt"
+
+// Examples:
+// $SDDPT,2.4,0.5,10.0*67
+// $SDDPT,12.6,-1.2,100.0*4F
+
+func NewDPT(m Message) *DPT {
+	return &DPT{Message: m}
+}
+
+// DPT is the water depth relative to the transducer with the offset of the transducer
+type DPT struct {
+	Message
+
+	Depth    *float64 // Water depth relative to the transducer in meters, empty if not available
+	Offset   *float64 // Offset from transducer in meters, positive means distance from transducer to water line, negative means distance from transducer to keel, empty if not available
+	MaxRange *float64 // Maximum range scale in use in meters (NMEA 3.0), empty if not available
+}
+
+func (m *DPT) parse() (err error) {
+	if len(m.Fields) != 2 && len(m.Fields) != 3 {
+		return m.Error(fmt.Errorf("Incomplete DPT message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 2, 3))
+	}
+
+	if m.Depth, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse depth from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.Offset, err = parseOptionalFloat(m.Fields[1]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse transducer offset from data field (got: %s)", m.Fields[1]))
+	}
+
+	if len(m.Fields) == 3 {
+		if m.MaxRange, err = parseOptionalFloat(m.Fields[2]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse maximum range scale from data field (got: %s)", m.Fields[2]))
+		}
+	}
+
+	return nil
+}
+
+func (m DPT) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("DPT")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		formatOptionalFloat(m.Depth, m.field(0), "%.1f"),
+		formatOptionalFloat(m.Offset, m.field(1), "%.1f"),
+	)
+
+	// Maximum range scale only since NMEA 3.0
+	if m.MaxRange != nil || len(m.Fields) == 3 {
+		fields = append(fields, formatOptionalFloat(m.MaxRange, m.field(2), "%.1f"))
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// DepthBelowSurface return water depth relative to the water line, nil if depth is not available
+// or offset is relative to the keel (an empty offset is handled as zero)
+func (m DPT) DepthBelowSurface() *float64 {
+	if m.Depth == nil || (m.Offset != nil && *m.Offset < 0) {
+		return nil
+	}
+	return m.depthWithOffset()
+}
+
+// DepthBelowKeel return water depth relative to the keel, nil if depth is not available
+// or offset is relative to the water line (an empty offset is handled as zero)
+func (m DPT) DepthBelowKeel() *float64 {
+	if m.Depth == nil || (m.Offset != nil && *m.Offset > 0) {
+		return nil
+	}
+	return m.depthWithOffset()
+}
+
+// depthWithOffset return depth corrected by transducer offset
+func (m DPT) depthWithOffset() *float64 {
+	v := *m.Depth
+	if m.Offset != nil {
+		v += *m.Offset
+	}
+	return &v
+}
@@ -0,0 +1,101 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// WGS84Eccentricity2 is the first eccentricity squared of the WGS84 ellipsoid
+	WGS84Eccentricity2 = WGS84Flattening * (2 - WGS84Flattening)
+)
+
+// ECEF is a cartesian position in meter (Earth-Centered, Earth-Fixed) on WGS84 ellipsoid
+type ECEF struct {
+	X, Y, Z float64
+}
+
+// ENU is a cartesian position in meter in the local East, North, Up frame of an origin
+type ENU struct {
+	East, North, Up float64
+}
+
+// ECEF return cartesian coordinates of position at altitude in meter above WGS84 ellipsoid
+func (p Position) ECEF(altitude float64) ECEF {
+	lat, lon := p.radians()
+	sinLat, cosLat := math.Sincos(lat)
+	sinLon, cosLon := math.Sincos(lon)
+	n := WGS84SemiMajorAxis / math.Sqrt(1-WGS84Eccentricity2*sinLat*sinLat) // Prime vertical radius of curvature
+
+	return ECEF{
+		X: (n + altitude) * cosLat * cosLon,
+		Y: (n + altitude) * cosLat * sinLon,
+		Z: (n*(1-WGS84Eccentricity2) + altitude) * sinLat,
+	}
+}
+
+// Position return position and altitude in meter above WGS84 ellipsoid of cartesian coordinates
+func (e ECEF) Position() (Position, float64) {
+	const (
+		a, b = WGS84SemiMajorAxis, WGS84SemiMinorAxis
+		e2   = WGS84Eccentricity2
+	)
+
+	lon := math.Atan2(e.Y, e.X)
+	p := math.Hypot(e.X, e.Y)
+	if p < 1e-9 { // On polar axis
+		lat := math.Copysign(math.Pi/2, e.Z)
+		return positionFromRadians(lat, lon), math.Abs(e.Z) - b
+	}
+
+	// Bowring's formula followed by iterations to reach sub-millimeter accuracy
+	ep2 := (a*a - b*b) / (b * b)
+	beta := math.Atan2(a*e.Z, b*p)
+	lat := math.Atan2(e.Z+ep2*b*math.Pow(math.Sin(beta), 3), p-e2*a*math.Pow(math.Cos(beta), 3))
+
+	var altitude float64
+	for i := 0; i < 5; i++ {
+		sinLat := math.Sin(lat)
+		n := a / math.Sqrt(1-e2*sinLat*sinLat)
+		altitude = p/math.Cos(lat) - n
+		lat = math.Atan2(e.Z, p*(1-e2*n/(n+altitude)))
+	}
+
+	return positionFromRadians(lat, lon), altitude
+}
+
+// ENU return local East, North, Up coordinates relative to origin position at altitude in meter
+func (e ECEF) ENU(origin Position, altitude float64) ENU {
+	o := origin.ECEF(altitude)
+	east, north, up := ecefToENU(e.X-o.X, e.Y-o.Y, e.Z-o.Z, origin)
+	return ENU{East: east, North: north, Up: up}
+}
+
+// ECEF return cartesian coordinates of local East, North, Up coordinates relative to origin position at altitude in meter
+func (n ENU) ECEF(origin Position, altitude float64) ECEF {
+	lat, lon := origin.radians()
+	sinLat, cosLat := math.Sincos(lat)
+	sinLon, cosLon := math.Sincos(lon)
+
+	o := origin.ECEF(altitude)
+	return ECEF{
+		X: o.X - sinLon*n.East - sinLat*cosLon*n.North + cosLat*cosLon*n.Up,
+		Y: o.Y + cosLon*n.East - sinLat*sinLon*n.North + cosLat*sinLon*n.Up,
+		Z: o.Z + cosLat*n.North + sinLat*n.Up,
+	}
+}
+
+// Position return position and altitude in meter above WGS84 ellipsoid of local East, North, Up coordinates
+// relative to origin position at altitude in meter
+func (n ENU) Position(origin Position, altitude float64) (Position, float64) {
+	return n.ECEF(origin, altitude).Position()
+}
+
+// ECEF return cartesian coordinates of the fix at its ellipsoidal height (altitude above mean sea level plus geoid
+// separation), error if geoid separation is not available
+func (m GPGGA) ECEF() (ECEF, error) {
+	if m.GeoIDSep == nil {
+		return ECEF{}, fmt.Errorf("Geoid separation not available")
+	}
+	return m.Position().ECEF(m.Altitude + *m.GeoIDSep), nil
+}
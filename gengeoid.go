@@ -0,0 +1,61 @@
+//go:build ignore
+// +build ignore
+
+// Downsample a geoid grid in NGA ASCII format (ie: WW15MGH.GRD of EGM96) to embed it as DefaultGeoid
+//
+// Usage: go run gengeoid.go WW15MGH.GRD 1 > data/egm96-1.grd
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+
+	nmea "github.com/pilebones/go-nmea"
+)
+
+func main() {
+	if len(os.Args) != 3 {
+		fmt.Fprintln(os.Stderr, "Usage: go run gengeoid.go <grid file> <step in degree>")
+		os.Exit(2)
+	}
+
+	step, err := strconv.ParseFloat(os.Args[2], 64)
+	if err != nil || step <= 0 {
+		fmt.Fprintf(os.Stderr, "Invalid step (got: %s)\n", os.Args[2])
+		os.Exit(2)
+	}
+
+	f, err := os.Open(os.Args[1])
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	g, err := nmea.ParseGeoidGrid(f)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+
+	// Keep nodes of the source grid falling on the new spacing
+	latStride, longStride := int(math.Round(step/g.LatStep)), int(math.Round(step/g.LongStep))
+	if latStride < 1 || longStride < 1 || math.Abs(float64(latStride)*g.LatStep-step) > 1e-9 || math.Abs(float64(longStride)*g.LongStep-step) > 1e-9 {
+		fmt.Fprintf(os.Stderr, "Step should be a multiple of grid spacing (got: %f, spacing: %f, %f)\n", step, g.LatStep, g.LongStep)
+		os.Exit(2)
+	}
+
+	w := bufio.NewWriter(os.Stdout)
+	defer w.Flush()
+
+	fmt.Fprintf(w, "%f %f %f %f %f %f\n", g.South, g.North, g.West, g.East, step, step)
+	for i := 0; i < len(g.Undulations); i += latStride {
+		for j := 0; j < len(g.Undulations[i]); j += longStride {
+			fmt.Fprintf(w, " %.3f", g.Undulations[i][j])
+		}
+		fmt.Fprintln(w)
+	}
+}
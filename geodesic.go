@@ -0,0 +1,246 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// WGS84 ellipsoid
+	// WGS84SemiMajorAxis is the equatorial radius in meter
+	WGS84SemiMajorAxis = 6378137.0
+	// WGS84Flattening is the flattening of the ellipsoid
+	WGS84Flattening = 1 / 298.257223563
+	// WGS84SemiMinorAxis is the polar radius in meter
+	WGS84SemiMinorAxis = WGS84SemiMajorAxis * (1 - WGS84Flattening)
+
+	// EarthMeanRadius is the mean radius (IUGG) in meter used by spherical formulas
+	EarthMeanRadius = 6371008.8
+)
+
+// Position is a point on earth surface
+type Position struct {
+	Latitude  Latitude
+	Longitude Longitude
+}
+
+// NewPosition return position from generic coordinates or error when out of range
+func NewPosition(latitude, longitude LatLong) (p Position, err error) {
+	if p.Latitude, err = latitude.Latitude(); err != nil {
+		return
+	}
+	p.Longitude, err = longitude.Longitude()
+	return
+}
+
+// Position return the position of the fix
+func (m GPGGA) Position() Position {
+	return Position{Latitude: m.Latitude, Longitude: m.Longitude}
+}
+
+// Position return the position of the fix
+func (m GNS) Position() Position {
+	return Position{Latitude: m.Latitude, Longitude: m.Longitude}
+}
+
+// Position return the position of the fix
+func (m GPRMC) Position() Position {
+	return Position{Latitude: m.Latitude, Longitude: m.Longitude}
+}
+
+// Position return the position of the fix
+func (m GPGLL) Position() Position {
+	return Position{Latitude: m.Latitude, Longitude: m.Longitude}
+}
+
+// radians return latitude and longitude in radian
+func (p Position) radians() (lat, lon float64) {
+	return float64(p.Latitude) * math.Pi / 180, float64(p.Longitude) * math.Pi / 180
+}
+
+// positionFromRadians return position from latitude and longitude in radian (longitude normalized to -180..180)
+func positionFromRadians(lat, lon float64) Position {
+	lon = math.Mod(lon+3*math.Pi, 2*math.Pi) - math.Pi
+	return Position{Latitude: Latitude(lat * 180 / math.Pi), Longitude: Longitude(lon * 180 / math.Pi)}
+}
+
+// bearingFromRadians return bearing in degree (0 to 360) from angle in radian
+func bearingFromRadians(theta float64) float64 {
+	return normalizeDegrees(theta * 180 / math.Pi)
+}
+
+// HaversineDistance return great-circle distance in meter on a sphere of EarthMeanRadius
+func (p Position) HaversineDistance(to Position) float64 {
+	lat1, lon1 := p.radians()
+	lat2, lon2 := to.radians()
+
+	a := math.Pow(math.Sin((lat2-lat1)/2), 2) + math.Cos(lat1)*math.Cos(lat2)*math.Pow(math.Sin((lon2-lon1)/2), 2)
+	return 2 * EarthMeanRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// InitialBearing return great-circle initial bearing in degree (0 to 360) from position to destination
+func (p Position) InitialBearing(to Position) float64 {
+	lat1, lon1 := p.radians()
+	lat2, lon2 := to.radians()
+
+	y := math.Sin(lon2-lon1) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(lon2-lon1)
+	return bearingFromRadians(math.Atan2(y, x))
+}
+
+// FinalBearing return great-circle bearing in degree (0 to 360) when arriving to destination
+func (p Position) FinalBearing(to Position) float64 {
+	return normalizeDegrees(to.InitialBearing(p) + 180)
+}
+
+// RhumbDistance return distance in meter along the rhumb line (constant bearing) on a sphere of EarthMeanRadius
+func (p Position) RhumbDistance(to Position) float64 {
+	lat1, lon1 := p.radians()
+	lat2, lon2 := to.radians()
+
+	dLat, dLon := lat2-lat1, lon2-lon1
+	if math.Abs(dLon) > math.Pi { // Take shortest way across anti-meridian
+		dLon -= math.Copysign(2*math.Pi, dLon)
+	}
+
+	q := math.Cos(lat1) // East-West line
+	if dPsi := math.Log(math.Tan(math.Pi/4+lat2/2) / math.Tan(math.Pi/4+lat1/2)); math.Abs(dPsi) > 1e-12 {
+		q = dLat / dPsi
+	}
+
+	return math.Sqrt(dLat*dLat+q*q*dLon*dLon) * EarthMeanRadius
+}
+
+// RhumbBearing return constant bearing in degree (0 to 360) of the rhumb line from position to destination
+func (p Position) RhumbBearing(to Position) float64 {
+	lat1, lon1 := p.radians()
+	lat2, lon2 := to.radians()
+
+	dLon := lon2 - lon1
+	if math.Abs(dLon) > math.Pi { // Take shortest way across anti-meridian
+		dLon -= math.Copysign(2*math.Pi, dLon)
+	}
+
+	dPsi := math.Log(math.Tan(math.Pi/4+lat2/2) / math.Tan(math.Pi/4+lat1/2))
+	return bearingFromRadians(math.Atan2(dLon, dPsi))
+}
+
+// Inverse return distance in meter on WGS84 ellipsoid and initial/final bearings in degree (0 to 360)
+// between position and destination using Vincenty's formulae, error if it fails to converge (nearly antipodal points)
+func (p Position) Inverse(to Position) (distance, initialBearing, finalBearing float64, err error) {
+	const (
+		a, b, f = WGS84SemiMajorAxis, WGS84SemiMinorAxis, WGS84Flattening
+	)
+
+	lat1, lon1 := p.radians()
+	lat2, lon2 := to.radians()
+
+	L := lon2 - lon1
+	U1, U2 := math.Atan((1-f)*math.Tan(lat1)), math.Atan((1-f)*math.Tan(lat2))
+	sinU1, cosU1 := math.Sincos(U1)
+	sinU2, cosU2 := math.Sincos(U2)
+
+	var (
+		lambda                    = L
+		sinLambda, cosLambda      float64
+		sinSigma, cosSigma, sigma float64
+		cosSqAlpha, cos2SigmaM    float64
+		converged                 bool
+	)
+
+	for i := 0; i < 200; i++ {
+		sinLambda, cosLambda = math.Sincos(lambda)
+		sinSigma = math.Sqrt(math.Pow(cosU2*sinLambda, 2) + math.Pow(cosU1*sinU2-sinU1*cosU2*cosLambda, 2))
+		if sinSigma == 0 { // Coincident points
+			return 0, 0, 0, nil
+		}
+		cosSigma = sinU1*sinU2 + cosU1*cosU2*cosLambda
+		sigma = math.Atan2(sinSigma, cosSigma)
+
+		sinAlpha := cosU1 * cosU2 * sinLambda / sinSigma
+		cosSqAlpha = 1 - sinAlpha*sinAlpha
+		cos2SigmaM = 0 // Equatorial line
+		if cosSqAlpha != 0 {
+			cos2SigmaM = cosSigma - 2*sinU1*sinU2/cosSqAlpha
+		}
+
+		C := f / 16 * cosSqAlpha * (4 + f*(4-3*cosSqAlpha))
+		previous := lambda
+		lambda = L + (1-C)*f*sinAlpha*(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+
+		if math.Abs(lambda-previous) < 1e-12 {
+			converged = true
+			break
+		}
+	}
+
+	if !converged {
+		err = fmt.Errorf("Vincenty formula failed to converge (nearly antipodal points)")
+		return
+	}
+
+	uSq := cosSqAlpha * (a*a - b*b) / (b * b)
+	A := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	B := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+	deltaSigma := B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+		B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+
+	distance = b * A * (sigma - deltaSigma)
+	initialBearing = bearingFromRadians(math.Atan2(cosU2*sinLambda, cosU1*sinU2-sinU1*cosU2*cosLambda))
+	finalBearing = bearingFromRadians(math.Atan2(cosU1*sinLambda, -sinU1*cosU2+cosU1*sinU2*cosLambda))
+	return
+}
+
+// Distance return distance in meter on WGS84 ellipsoid (see Inverse)
+func (p Position) Distance(to Position) (float64, error) {
+	distance, _, _, err := p.Inverse(to)
+	return distance, err
+}
+
+// Destination return position reached from position following initial bearing in degree for distance in meter
+// on WGS84 ellipsoid and final bearing in degree (0 to 360) using Vincenty's direct formulae
+func (p Position) Destination(bearing, distance float64) (Position, float64) {
+	const (
+		a, b, f = WGS84SemiMajorAxis, WGS84SemiMinorAxis, WGS84Flattening
+	)
+
+	lat1, lon1 := p.radians()
+	alpha1 := bearing * math.Pi / 180
+	sinAlpha1, cosAlpha1 := math.Sincos(alpha1)
+
+	tanU1 := (1 - f) * math.Tan(lat1)
+	cosU1 := 1 / math.Sqrt(1+tanU1*tanU1)
+	sinU1 := tanU1 * cosU1
+
+	sigma1 := math.Atan2(tanU1, cosAlpha1)
+	sinAlpha := cosU1 * sinAlpha1
+	cosSqAlpha := 1 - sinAlpha*sinAlpha
+
+	uSq := cosSqAlpha * (a*a - b*b) / (b * b)
+	A := 1 + uSq/16384*(4096+uSq*(-768+uSq*(320-175*uSq)))
+	B := uSq / 1024 * (256 + uSq*(-128+uSq*(74-47*uSq)))
+
+	var sinSigma, cosSigma, cos2SigmaM float64
+	sigma := distance / (b * A)
+	for i := 0; i < 200; i++ {
+		cos2SigmaM = math.Cos(2*sigma1 + sigma)
+		sinSigma, cosSigma = math.Sincos(sigma)
+		deltaSigma := B * sinSigma * (cos2SigmaM + B/4*(cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)-
+			B/6*cos2SigmaM*(-3+4*sinSigma*sinSigma)*(-3+4*cos2SigmaM*cos2SigmaM)))
+
+		previous := sigma
+		if sigma = distance/(b*A) + deltaSigma; math.Abs(sigma-previous) < 1e-12 {
+			break
+		}
+	}
+	cos2SigmaM = math.Cos(2*sigma1 + sigma)
+	sinSigma, cosSigma = math.Sincos(sigma)
+
+	x := sinU1*sinSigma - cosU1*cosSigma*cosAlpha1
+	lat2 := math.Atan2(sinU1*cosSigma+cosU1*sinSigma*cosAlpha1, (1-f)*math.Sqrt(sinAlpha*sinAlpha+x*x))
+	lambda := math.Atan2(sinSigma*sinAlpha1, cosU1*cosSigma-sinU1*sinSigma*cosAlpha1)
+	C := f / 16 * cosSqAlpha * (4 + f*(4-3*cosSqAlpha))
+	L := lambda - (1-C)*f*sinAlpha*(sigma+C*sinSigma*(cos2SigmaM+C*cosSigma*(-1+2*cos2SigmaM*cos2SigmaM)))
+
+	return positionFromRadians(lat2, lon1+L), bearingFromRadians(math.Atan2(sinAlpha, -x))
+}
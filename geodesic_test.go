@@ -0,0 +1,96 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+)
+
+// dms return decimal degrees from degrees, minutes and seconds
+func dms(d, m, s float64) float64 {
+	if d < 0 {
+		return d - m/60 - s/3600
+	}
+	return d + m/60 + s/3600
+}
+
+func TestVincenty(t *testing.T) {
+	// Geoscience Australia reference: Flinders Peak to Buninyong
+	flindersPeak := Position{Latitude: Latitude(dms(-37, 57, 3.72030)), Longitude: Longitude(dms(144, 25, 29.52440))}
+	buninyong := Position{Latitude: Latitude(dms(-37, 39, 10.15610)), Longitude: Longitude(dms(143, 55, 35.38390))}
+
+	distance, initialBearing, finalBearing, err := flindersPeak.Inverse(buninyong)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(distance-54972.271) > 1e-3 {
+		t.Fatalf("Wrong distance (got: %.3f, wanted: %.3f)", distance, 54972.271)
+	}
+	if expected := dms(306, 52, 5.37); math.Abs(initialBearing-expected) > 0.01/3600 {
+		t.Fatalf("Wrong initial bearing (got: %f, wanted: %f)", initialBearing, expected)
+	}
+	if expected := dms(307, 10, 25.07); math.Abs(finalBearing-expected) > 0.01/3600 {
+		t.Fatalf("Wrong final bearing (got: %f, wanted: %f)", finalBearing, expected)
+	}
+
+	destination, bearing := flindersPeak.Destination(dms(306, 52, 5.37), 54972.271)
+	if math.Abs(float64(destination.Latitude-buninyong.Latitude)) > 1e-4/3600 || math.Abs(float64(destination.Longitude-buninyong.Longitude)) > 1e-4/3600 {
+		t.Fatalf("Wrong destination (got: %+v, wanted: %+v)", destination, buninyong)
+	}
+	if expected := dms(307, 10, 25.07); math.Abs(bearing-expected) > 0.01/3600 {
+		t.Fatalf("Wrong final bearing (got: %f, wanted: %f)", bearing, expected)
+	}
+
+	if distance, err := flindersPeak.Distance(flindersPeak); err != nil || distance != 0 {
+		t.Fatalf("Wrong distance between coincident points (got: %f, err: %v)", distance, err)
+	}
+
+	// Nearly antipodal points
+	if _, err := (Position{}).Distance(Position{Latitude: 0.5, Longitude: 179.7}); err == nil {
+		t.Fatal("Vincenty formula shouldn't converge for nearly antipodal points")
+	}
+}
+
+func TestGreatCircle(t *testing.T) {
+	// Nashville International Airport (BNA) to Los Angeles International Airport (LAX),
+	// 2887.2599506071106 km with a radius of 6372.8 km
+	bna := Position{Latitude: 36.12, Longitude: -86.67}
+	lax := Position{Latitude: 33.94, Longitude: -118.40}
+	if distance, expected := bna.HaversineDistance(lax), 2887259.9506071106*EarthMeanRadius/6372800; math.Abs(distance-expected) > 1e-6 {
+		t.Fatalf("Wrong haversine distance (got: %f, wanted: %f)", distance, expected)
+	}
+
+	// Land's End to John o' Groats
+	landsEnd := Position{Latitude: Latitude(dms(50, 3, 59)), Longitude: Longitude(dms(-5, 42, 53))}
+	johnOGroats := Position{Latitude: Latitude(dms(58, 38, 38)), Longitude: Longitude(dms(-3, 4, 12))}
+	if distance := landsEnd.HaversineDistance(johnOGroats); math.Abs(distance-968.9e3) > 100 {
+		t.Fatalf("Wrong haversine distance (got: %f, wanted: %f)", distance, 968.9e3)
+	}
+	if bearing, expected := landsEnd.InitialBearing(johnOGroats), dms(9, 7, 11); math.Abs(bearing-expected) > 1.0/3600 {
+		t.Fatalf("Wrong initial bearing (got: %f, wanted: %f)", bearing, expected)
+	}
+	if bearing, expected := landsEnd.FinalBearing(johnOGroats), dms(11, 16, 31); math.Abs(bearing-expected) > 1.0/3600 {
+		t.Fatalf("Wrong final bearing (got: %f, wanted: %f)", bearing, expected)
+	}
+}
+
+func TestRhumbLine(t *testing.T) {
+	// Dover to Calais
+	dover := Position{Latitude: Latitude(dms(51, 7, 32)), Longitude: Longitude(dms(1, 20, 17))}
+	calais := Position{Latitude: Latitude(dms(50, 57, 48)), Longitude: Longitude(dms(1, 51, 9))}
+
+	if distance := dover.RhumbDistance(calais); math.Abs(distance-40.23e3) > 10 {
+		t.Fatalf("Wrong rhumb distance (got: %f, wanted: %f)", distance, 40.23e3)
+	}
+	if bearing, expected := dover.RhumbBearing(calais), dms(116, 38, 10); math.Abs(bearing-expected) > 1.0/3600 {
+		t.Fatalf("Wrong rhumb bearing (got: %f, wanted: %f)", bearing, expected)
+	}
+
+	// Along a parallel, across anti-meridian
+	west, east := Position{Latitude: 60, Longitude: 179.5}, Position{Latitude: 60, Longitude: -179.5}
+	if bearing := west.RhumbBearing(east); math.Abs(bearing-90) > 1e-9 {
+		t.Fatalf("Wrong rhumb bearing (got: %f, wanted: %f)", bearing, 90.0)
+	}
+	if distance, expected := west.RhumbDistance(east), EarthMeanRadius*math.Pi/180*math.Cos(math.Pi/3); math.Abs(distance-expected) > 1e-6 {
+		t.Fatalf("Wrong rhumb distance (got: %f, wanted: %f)", distance, expected)
+	}
+}
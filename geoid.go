@@ -0,0 +1,202 @@
+package nmea
+
+import (
+	"bufio"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"strconv"
+)
+
+// DefaultGeoidFile is the embedded EGM96 grid downsampled to 1 degree from WW15MGH.GRD of NGA (see data/README.md)
+const DefaultGeoidFile = "data/egm96-1.grd"
+
+var (
+	//go:embed data
+	embedded embed.FS
+
+	// DefaultGeoid is the geoid grid used by GGA and GNS helpers when none is provided,
+	// loaded from embedded DefaultGeoidFile (nil when the grid isn't bundled)
+	DefaultGeoid = mustLoadGeoidGrid(embedded, DefaultGeoidFile)
+)
+
+// GeoidGrid is a regular latitude/longitude grid of geoid undulations in meter (height of the geoid above WGS84 ellipsoid)
+// like the EGM96 15' grid distributed by NGA (WW15MGH.GRD)
+type GeoidGrid struct {
+	South, North float64     // Latitude limits in degree
+	West, East   float64     // Longitude limits in degree (0 to 360 or -180 to 180)
+	LatStep      float64     // Latitude spacing in degree
+	LongStep     float64     // Longitude spacing in degree
+	Undulations  [][]float64 // Rows from north to south, columns from west to east
+}
+
+// ParseGeoidGrid read a geoid grid in NGA ASCII format: a header with south, north, west, east limits and
+// latitude, longitude spacing in degree followed by undulations rows from north to south (ie: WW15MGH.GRD)
+func ParseGeoidGrid(r io.Reader) (*GeoidGrid, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Split(bufio.ScanWords)
+
+	values := make([]float64, 0)
+	for scanner.Scan() {
+		v, err := strconv.ParseFloat(scanner.Text(), 64)
+		if err != nil {
+			return nil, fmt.Errorf("Unable to parse geoid grid value (got: %s)", scanner.Text())
+		}
+		values = append(values, v)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if len(values) < 6 {
+		return nil, fmt.Errorf("Incomplete geoid grid header, not enougth values (got: %d, wanted: %d)", len(values), 6)
+	}
+
+	g := &GeoidGrid{South: values[0], North: values[1], West: values[2], East: values[3], LatStep: values[4], LongStep: values[5]}
+	if g.LatStep <= 0 || g.LongStep <= 0 || g.North <= g.South || g.East <= g.West {
+		return nil, fmt.Errorf("Invalid geoid grid header (got: %v)", values[:6])
+	}
+
+	rows := int(math.Round((g.North-g.South)/g.LatStep)) + 1
+	cols := int(math.Round((g.East-g.West)/g.LongStep)) + 1
+	if values = values[6:]; len(values) != rows*cols {
+		return nil, fmt.Errorf("Wrong number of geoid grid values (got: %d, wanted: %d)", len(values), rows*cols)
+	}
+
+	g.Undulations = make([][]float64, rows)
+	for i := range g.Undulations {
+		g.Undulations[i] = values[i*cols : (i+1)*cols]
+	}
+	return g, nil
+}
+
+// LoadGeoidGrid read a geoid grid in NGA ASCII format from file system (see ParseGeoidGrid)
+func LoadGeoidGrid(fsys fs.FS, name string) (*GeoidGrid, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseGeoidGrid(f)
+}
+
+// mustLoadGeoidGrid return embedded geoid grid, nil if not bundled and panic if invalid
+func mustLoadGeoidGrid(fsys fs.FS, name string) *GeoidGrid {
+	g, err := LoadGeoidGrid(fsys, name)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return nil
+	case err != nil:
+		panic(fmt.Sprintf("Invalid embedded geoid grid %s: %s", name, err.Error()))
+	}
+	return g
+}
+
+// Undulation return geoid height in meter above WGS84 ellipsoid at position using bilinear interpolation,
+// error if position is outside of the grid
+func (g *GeoidGrid) Undulation(p Position) (float64, error) {
+	lat, lon := float64(p.Latitude), float64(p.Longitude)
+
+	// Shift longitude into grid limits
+	for lon < g.West {
+		lon += 360
+	}
+	for lon > g.East && lon-360 >= g.West {
+		lon -= 360
+	}
+
+	if lat < g.South || lat > g.North || lon < g.West || lon > g.East {
+		return 0, fmt.Errorf("Position outside of geoid grid (got: %f, %f)", p.Latitude, p.Longitude)
+	}
+
+	rows, cols := len(g.Undulations), len(g.Undulations[0])
+
+	// Fractional indexes in grid (rows from north to south)
+	y, x := (g.North-lat)/g.LatStep, (lon-g.West)/g.LongStep
+	i, j := int(math.Min(math.Floor(y), float64(rows-2))), int(math.Min(math.Floor(x), float64(cols-2)))
+	dy, dx := y-float64(i), x-float64(j)
+
+	n := g.Undulations
+	return n[i][j]*(1-dx)*(1-dy) + n[i][j+1]*dx*(1-dy) + n[i+1][j]*(1-dx)*dy + n[i+1][j+1]*dx*dy, nil
+}
+
+// EllipsoidalHeight return height above WGS84 ellipsoid from altitude above mean sea level (geoid) at position
+func (g *GeoidGrid) EllipsoidalHeight(p Position, altitude float64) (float64, error) {
+	n, err := g.Undulation(p)
+	return altitude + n, err
+}
+
+// MSLAltitude return altitude above mean sea level (geoid) from height above WGS84 ellipsoid at position
+func (g *GeoidGrid) MSLAltitude(p Position, height float64) (float64, error) {
+	n, err := g.Undulation(p)
+	return height - n, err
+}
+
+// Undulation return geoid separation of the fix, from data field when available or computed from geoid grid
+// (DefaultGeoid if nil)
+func (m GPGGA) Undulation(geoid *GeoidGrid) (float64, error) {
+	if m.GeoIDSep != nil {
+		return *m.GeoIDSep, nil
+	}
+	if geoid == nil {
+		geoid = DefaultGeoid
+	}
+	if geoid == nil {
+		return 0, fmt.Errorf("Geoid separation not available")
+	}
+	return geoid.Undulation(m.Position())
+}
+
+// EllipsoidalHeight return height above WGS84 ellipsoid of the fix (see Undulation)
+func (m GPGGA) EllipsoidalHeight(geoid *GeoidGrid) (float64, error) {
+	n, err := m.Undulation(geoid)
+	return m.Altitude + n, err
+}
+
+// SetEllipsoidalHeight set altitude above mean sea level and geoid separation of the fix from height above WGS84 ellipsoid
+func (m *GPGGA) SetEllipsoidalHeight(height float64, geoid *GeoidGrid) error {
+	n, err := m.Undulation(geoid)
+	if err != nil {
+		return err
+	}
+	m.Altitude, m.GeoIDSep = height-n, &n
+	return nil
+}
+
+// Undulation return geoid separation of the fix, from data field when available or computed from geoid grid
+// (DefaultGeoid if nil)
+func (m GNS) Undulation(geoid *GeoidGrid) (float64, error) {
+	if m.GeoIDSep != nil {
+		return *m.GeoIDSep, nil
+	}
+	if geoid == nil {
+		geoid = DefaultGeoid
+	}
+	if geoid == nil {
+		return 0, fmt.Errorf("Geoid separation not available")
+	}
+	return geoid.Undulation(m.Position())
+}
+
+// EllipsoidalHeight return height above WGS84 ellipsoid of the fix (see Undulation), error if altitude isn't available
+func (m GNS) EllipsoidalHeight(geoid *GeoidGrid) (float64, error) {
+	if m.Altitude == nil {
+		return 0, fmt.Errorf("Altitude not available")
+	}
+	n, err := m.Undulation(geoid)
+	return *m.Altitude + n, err
+}
+
+// SetEllipsoidalHeight set altitude above mean sea level and geoid separation of the fix from height above WGS84 ellipsoid
+func (m *GNS) SetEllipsoidalHeight(height float64, geoid *GeoidGrid) error {
+	n, err := m.Undulation(geoid)
+	if err != nil {
+		return err
+	}
+	altitude := height - n
+	m.Altitude, m.GeoIDSep = &altitude, &n
+	return nil
+}
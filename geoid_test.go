@@ -0,0 +1,160 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestGeoidGrid(t *testing.T) {
+	// Synthetic grid where undulation is a linear function of latitude and longitude (exact with bilinear interpolation)
+	undulation := func(lat, lon float64) float64 { return lat/10 + lon/100 }
+
+	var raw strings.Builder
+	raw.WriteString("-90.000000 90.000000 .000000 360.000000 45.000000 90.000000\n")
+	for lat := 90.0; lat >= -90; lat -= 45 {
+		for lon := 0.0; lon <= 360; lon += 90 {
+			fmt.Fprintf(&raw, " %.3f", undulation(lat, lon))
+		}
+		raw.WriteString("\n")
+	}
+
+	geoid, err := ParseGeoidGrid(strings.NewReader(raw.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, p := range []Position{
+		{Latitude: 0, Longitude: 0},
+		{Latitude: 31.8447, Longitude: 117.1989},
+		{Latitude: -33.8567844, Longitude: 151.2152967},
+		{Latitude: 90, Longitude: 180},
+		{Latitude: -90, Longitude: 360},
+	} {
+		n, err := geoid.Undulation(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if expected := undulation(float64(p.Latitude), float64(p.Longitude)); math.Abs(n-expected) > 1e-9 {
+			t.Fatalf("Wrong undulation at %+v (got: %f, wanted: %f)", p, n, expected)
+		}
+	}
+
+	// Western longitudes wrap around grid expressed from 0 to 360
+	if n, err := geoid.Undulation(Position{Latitude: 45, Longitude: -90}); err != nil || math.Abs(n-undulation(45, 270)) > 1e-9 {
+		t.Fatalf("Wrong undulation for western longitude (got: %f, err: %v)", n, err)
+	}
+
+	// GGA without geoid separation
+	rawGGA := "$GPGGA,015540,3150.68378,N,11711.93139,E,1,17,0.6,0051.6,M,,M,,*68"
+	msg, err := Parse(rawGGA)
+	if err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", rawGGA, err.Error())
+	}
+	gga := msg.(*GPGGA)
+
+	defaultGeoid := DefaultGeoid
+	DefaultGeoid = nil
+	_, err = gga.EllipsoidalHeight(nil)
+	DefaultGeoid = defaultGeoid
+	if err == nil {
+		t.Fatal("Ellipsoidal height shouldn't be available without geoid separation")
+	}
+
+	expected := 51.6 + undulation(float64(gga.Latitude), float64(gga.Longitude))
+	if h, err := gga.EllipsoidalHeight(geoid); err != nil || math.Abs(h-expected) > 1e-9 {
+		t.Fatalf("Wrong ellipsoidal height (got: %f, wanted: %f, err: %v)", h, expected, err)
+	}
+
+	// Default geoid when none is provided
+	DefaultGeoid = geoid
+	h, err := gga.EllipsoidalHeight(nil)
+	DefaultGeoid = defaultGeoid
+	if err != nil || math.Abs(h-expected) > 1e-9 {
+		t.Fatalf("Wrong ellipsoidal height with default geoid (got: %f, wanted: %f, err: %v)", h, expected, err)
+	}
+
+	// Geoid separation from data field takes precedence
+	if err = gga.SetEllipsoidalHeight(100, geoid); err != nil {
+		t.Fatal(err)
+	}
+	if h, err := gga.EllipsoidalHeight(nil); err != nil || math.Abs(h-100) > 1e-9 {
+		t.Fatalf("Wrong ellipsoidal height (got: %f, err: %v)", h, err)
+	}
+
+	// GNS without geoid separation
+	rawGNS := "$GNGNS,014035.00,4332.69262,S,17235.48549,E,RR,13,0.9,25.63,,,*58"
+	if msg, err = Parse(rawGNS); err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", rawGNS, err.Error())
+	}
+	gns := msg.(*GNS)
+
+	expected = 25.63 + undulation(float64(gns.Latitude), float64(gns.Longitude))
+	if h, err := gns.EllipsoidalHeight(geoid); err != nil || math.Abs(h-expected) > 1e-9 {
+		t.Fatalf("Wrong GNS ellipsoidal height (got: %f, wanted: %f, err: %v)", h, expected, err)
+	}
+	if err = gns.SetEllipsoidalHeight(100, geoid); err != nil {
+		t.Fatal(err)
+	}
+	if h, err := gns.EllipsoidalHeight(nil); err != nil || math.Abs(h-100) > 1e-9 || math.Abs(*gns.Altitude+*gns.GeoIDSep-100) > 1e-9 {
+		t.Fatalf("Wrong GNS ellipsoidal height (got: %f, err: %v)", h, err)
+	}
+
+	gns.Altitude = nil
+	if _, err := gns.EllipsoidalHeight(geoid); err == nil {
+		t.Fatal("Ellipsoidal height shouldn't be available without altitude")
+	}
+
+	for _, invalid := range []string{
+		"-90 90 0 360 45",                  // Incomplete header
+		"90 -90 0 360 45 90 0",             // Wrong limits
+		"-90 90 0 360 45 90 1 2 3",         // Missing values
+		"-90 90 0 360 45 90 1 2 x 4 5 6 7", // Not a number
+	} {
+		if _, err := ParseGeoidGrid(strings.NewReader(invalid)); err == nil {
+			t.Fatalf("Geoid grid \"%s\" should be rejected", invalid)
+		}
+	}
+}
+
+func TestLoadGeoidGrid(t *testing.T) {
+	fsys := fstest.MapFS{"grid.grd": {Data: []byte("-90 90 0 360 90 180\n1 2 3\n4 5 6\n7 8 9\n")}}
+
+	geoid, err := LoadGeoidGrid(fsys, "grid.grd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, err := geoid.Undulation(Position{Latitude: 0, Longitude: 180}); err != nil || n != 5 {
+		t.Fatalf("Wrong undulation (got: %f, err: %v)", n, err)
+	}
+
+	// Missing grid isn't bundled
+	if geoid := mustLoadGeoidGrid(fsys, "missing.grd"); geoid != nil {
+		t.Fatal("Missing geoid grid should be nil")
+	}
+}
+
+func TestDefaultGeoid(t *testing.T) {
+	if DefaultGeoid == nil {
+		t.Skipf("EGM96 grid %s not bundled (see data/README.md)", DefaultGeoidFile)
+	}
+
+	// Test points published by NGA with EGM96 (INTPT.DAT and OUTINTPT.DAT), within interpolation error of 1 degree grid
+	for _, test := range []struct {
+		p          Position
+		undulation float64
+	}{
+		{Position{Latitude: 38.6281550, Longitude: 269.7791550 - 360}, -31.628},
+		{Position{Latitude: -14.6212170, Longitude: 305.0211140 - 360}, -2.969},
+		{Position{Latitude: 46.8743190, Longitude: 102.4487290}, -43.575},
+		{Position{Latitude: -23.6174460, Longitude: 133.8747120}, 15.871},
+		{Position{Latitude: 38.6254730, Longitude: 359.9995000 - 360}, 50.066},
+		{Position{Latitude: -0.4667440, Longitude: 0.0023000}, 17.329},
+	} {
+		if n, err := DefaultGeoid.Undulation(test.p); err != nil || math.Abs(n-test.undulation) > 2 {
+			t.Fatalf("Wrong EGM96 undulation at %+v (got: %f, wanted: %f, err: %v)", test.p, n, test.undulation, err)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Examples:
+// $GNGNS,014035.00,4332.69262,S,17235.48549,E,RR,13,0.9,25.63,11.24,,*70
+// $GNGNS,112257.00,3844.24011,N,00908.43828,W,AN,03,10.5,,,,,V*33
+
+func NewGNS(m Message) *GNS {
+	return &GNS{Message: m}
+}
+
+// GNS is the GNSS fix data of a multi-constellation receiver (positioning mode per constellation)
+type GNS struct {
+	Message
+
+	TimeUTC            time.Time         // Aggregation of TimeUTC data field, zero if not available
+	TimeDigits         int               // Number of fractional digits of TimeUTC data field
+	Latitude           Latitude          // In decimal format
+	Longitude          Longitude         // In decimal format
+	LatitudeDecimals   *int              // Number of decimals of minutes in latitude data field (NoCoordinate if empty), DefaultCoordinateDecimals if not specified (nil)
+	LongitudeDecimals  *int              // Number of decimals of minutes in longitude data field (NoCoordinate if empty), DefaultCoordinateDecimals if not specified (nil)
+	Modes              []PositioningMode // Positioning mode per constellation (GPS, GLONASS, Galileo, BeiDou, QZSS...)
+	NbOfSatellitesUsed uint64
+	HDOP               *float64
+	Altitude           *float64           // Altitude above mean sea level (geoid) in meter, empty if not available
+	GeoIDSep           *float64           // Geoid separation in meter (height of the geoid above WGS84 ellipsoid), empty if not available
+	DGPSAge            *float64           // Age of differential corrections in seconds, empty if not available
+	DGPSStationID      string             // Differential reference station ID (0000 ~ 1023), empty if not available
+	Status             NavigationalStatus // NMEA 4.1 and later, empty if not available
+}
+
+func (m *GNS) parse() (err error) {
+	if len(m.Fields) != 12 && len(m.Fields) != 13 {
+		return m.Error(fmt.Errorf("Incomplete GNS message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 12, 13))
+	}
+
+	if m.TimeUTC, m.TimeDigits, err = parseTimeOfDay(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.Latitude, m.LatitudeDecimals, err = parseLatitudeField(m.Fields[1], m.Fields[2]); err != nil {
+		return m.Error(err)
+	}
+
+	if m.Longitude, m.LongitudeDecimals, err = parseLongitudeField(m.Fields[3], m.Fields[4]); err != nil {
+		return m.Error(err)
+	}
+
+	m.Modes = make([]PositioningMode, 0, len(m.Fields[5]))
+	for _, raw := range m.Fields[5] {
+		mode, err := ParsePositioningMode(string(raw))
+		if err != nil {
+			return m.Error(fmt.Errorf("Unable to parse positioning mode from data field (got: %s)", m.Fields[5]))
+		}
+		m.Modes = append(m.Modes, mode)
+	}
+
+	if satellites := m.Fields[6]; len(satellites) > 0 {
+		if m.NbOfSatellitesUsed, err = strconv.ParseUint(satellites, 10, 0); err != nil {
+			return m.Error(err)
+		}
+	}
+
+	for i, v := range map[int]**float64{7: &m.HDOP, 8: &m.Altitude, 9: &m.GeoIDSep, 10: &m.DGPSAge} {
+		if *v, err = parseOptionalFloat(m.Fields[i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse float from data field %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	m.DGPSStationID = m.Fields[11]
+
+	if len(m.Fields) > 12 {
+		if m.Status, err = ParseNavigationalStatus(m.Fields[12]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse navigational status from data field (got: %s)", m.Fields[12]))
+		}
+	}
+
+	return nil
+}
+
+func (m GNS) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GNS")
+	fields := make([]string, 0)
+
+	fields = append(fields, serializeTimeOfDay(m.TimeUTC, m.TimeDigits))
+	fields = append(fields, serializeDMField(m.Latitude.LatLong(), true, m.LatitudeDecimals)...)
+	fields = append(fields, serializeDMField(m.Longitude.LatLong(), false, m.LongitudeDecimals)...)
+
+	var modes strings.Builder
+	for _, mode := range m.Modes {
+		modes.WriteString(mode.Serialize())
+	}
+
+	// Number of satellites is zero padded (ie: "08"), kept empty if not available
+	satellites := m.field(6)
+	if v, err := strconv.ParseUint(satellites, 10, 0); (err != nil || v != m.NbOfSatellitesUsed) && (len(satellites) > 0 || m.NbOfSatellitesUsed != 0) {
+		satellites = fmt.Sprintf("%02d", m.NbOfSatellitesUsed)
+	}
+
+	fields = append(fields,
+		modes.String(),
+		satellites,
+		formatOptionalFloat(m.HDOP, m.field(7), "%.1f"),
+		formatOptionalFloat(m.Altitude, m.field(8), "%.1f"),
+		formatOptionalFloat(m.GeoIDSep, m.field(9), "%.1f"),
+		formatOptionalFloat(m.DGPSAge, m.field(10), "%.1f"),
+		m.DGPSStationID,
+	)
+
+	if len(m.Status) > 0 || len(m.Fields) == 13 {
+		fields = append(fields, m.Status.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
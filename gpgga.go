@@ -3,12 +3,12 @@ package nmea
 import (
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 )
 
 // Examples:
 // $GPGGA,015540.000,3150.68378,N,11711.93139,E,1,17,0.6,0051.6,M,0.0,M,,*58
+// $GNGGA,101520.00,4807.03812,N,01131.00031,E,4,12,0.62,519.4,M,47.6,M,1.2,0031*67
 
 func NewGPGGA(m Message) *GPGGA {
 	return &GPGGA{Message: m}
@@ -17,18 +17,19 @@ func NewGPGGA(m Message) *GPGGA {
 type GPGGA struct {
 	Message
 
-	TimeUTC            time.Time // Aggregation of TimeUTC data field
-	Latitude           LatLong   // In decimal format
-	Longitude          LatLong   // In decimal format
+	TimeUTC            time.Time // Aggregation of TimeUTC data field, zero if not available
+	TimeDigits         int       // Number of fractional digits of TimeUTC data field
+	Latitude           Latitude  // In decimal format
+	Longitude          Longitude // In decimal format
+	LatitudeDecimals   *int      // Number of decimals of minutes in latitude data field (NoCoordinate if empty), DefaultCoordinateDecimals if not specified (nil)
+	LongitudeDecimals  *int      // Number of decimals of minutes in longitude data field (NoCoordinate if empty), DefaultCoordinateDecimals if not specified (nil)
 	QualityIndicator   QualityIndicator
 	NbOfSatellitesUsed uint64
 	HDOP               float64
 	Altitude           float64
 	GeoIDSep           *float64
-
-	// FIXME: Manage field below when I found a sample with no-empty data
-	// DGPSAge        *uint64
-	// DGPSiStationId *string
+	DGPSAge            *float64 // Age of differential corrections in seconds (DGPS and RTK fix), empty if not available
+	DGPSStationID      string   // Differential reference station ID (0000 ~ 1023), empty if not available
 }
 
 func (m *GPGGA) parse() (err error) {
@@ -43,20 +44,16 @@ func (m *GPGGA) parse() (err error) {
 		}
 	}
 
-	if m.TimeUTC, err = time.Parse("150405.000", m.Fields[0]); err != nil {
+	if m.TimeUTC, m.TimeDigits, err = parseTimeOfDay(m.Fields[0]); err != nil {
 		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[0]))
 	}
 
-	if latitude := strings.TrimSpace(strings.Join(m.Fields[1:3], " ")); len(latitude) > 0 {
-		if m.Latitude, err = NewLatLong(latitude); err != nil {
-			return m.Error(err)
-		}
+	if m.Latitude, m.LatitudeDecimals, err = parseLatitudeField(m.Fields[1], m.Fields[2]); err != nil {
+		return m.Error(err)
 	}
 
-	if longitude := strings.TrimSpace(strings.Join(m.Fields[3:5], " ")); len(longitude) > 0 {
-		if m.Longitude, err = NewLatLong(longitude); err != nil {
-			return m.Error(err)
-		}
+	if m.Longitude, m.LongitudeDecimals, err = parseLongitudeField(m.Fields[3], m.Fields[4]); err != nil {
+		return m.Error(err)
 	}
 
 	if m.QualityIndicator, err = ParseQualityIndicator(m.Fields[5]); err != nil {
@@ -86,46 +83,60 @@ func (m *GPGGA) parse() (err error) {
 		m.GeoIDSep = &id
 	}
 
+	if m.DGPSAge, err = parseOptionalFloat(m.Fields[12]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse age of differential corrections from data field (got: %s)", m.Fields[12]))
+	}
+
+	m.DGPSStationID = m.Fields[13]
+
 	return nil
 }
 
 func (m GPGGA) Serialize() string { // Implement NMEA interface
 
-	hdr := TypeIDs["GPGGA"]
+	hdr := m.header("GGA")
 	fields := make([]string, 0)
 
-	fields = append(fields, m.TimeUTC.Format("150405.000"),
-		strings.Trim(m.Latitude.Serialize(), "0"), m.Latitude.CardinalPoint(true).String(),
-		strings.Trim(m.Longitude.Serialize(), "0"), m.Longitude.CardinalPoint(false).String(),
-		strconv.Itoa(int(m.QualityIndicator)),
-		strconv.Itoa(int(m.NbOfSatellitesUsed)),
-	)
-
-	if m.HDOP > 0 {
-		fields = append(fields, fmt.Sprintf("%.1f", m.HDOP))
-	} else {
-		fields = append(fields, "")
+	fields = append(fields, serializeTimeOfDay(m.TimeUTC, m.TimeDigits))
+	fields = append(fields, serializeDMField(m.Latitude.LatLong(), true, m.LatitudeDecimals)...)
+	fields = append(fields, serializeDMField(m.Longitude.LatLong(), false, m.LongitudeDecimals)...)
+	// Number of satellites may be zero padded (ie: "08")
+	satellites := m.field(6)
+	if v, err := strconv.ParseUint(satellites, 10, 0); err != nil || v != m.NbOfSatellitesUsed {
+		satellites = strconv.Itoa(int(m.NbOfSatellitesUsed))
 	}
 
-	if m.Altitude > 0 {
-		fields = append(fields, PrependXZero(m.Altitude, "%.1f", 4))
+	fields = append(fields,
+		strconv.Itoa(int(m.QualityIndicator)),
+		satellites,
+	)
 
+	if m.HDOP != 0 || len(m.field(7)) > 0 {
+		fields = append(fields, formatFloat(m.HDOP, m.field(7), "%.1f"))
 	} else {
 		fields = append(fields, "")
 	}
 
-	fields = append(fields, "M")
-
-	if m.GeoIDSep != nil {
-		fields = append(fields, fmt.Sprintf("%.1f", *m.GeoIDSep))
-	} else {
-		fields = append(fields, "")
+	// Altitude is zero padded when crafted, and may be below mean sea level
+	altitude := m.field(8)
+	if v, err := strconv.ParseFloat(altitude, 64); err != nil || v != m.Altitude {
+		switch {
+		case m.Altitude > 0:
+			altitude = PrependXZero(m.Altitude, "%.1f", 4)
+		case m.Altitude < 0:
+			altitude = fmt.Sprintf("%.1f", m.Altitude)
+		default:
+			altitude = ""
+		}
 	}
 
 	fields = append(fields,
+		altitude,
+		"M",
+		formatOptionalFloat(m.GeoIDSep, m.field(10), "%.1f"),
 		"M",
-		"", // DGPSAge always empty ?
-		"", // DGPSiStationId always empty ?
+		formatOptionalFloat(m.DGPSAge, m.field(12), "%.1f"),
+		m.DGPSStationID,
 	)
 
 	msg := Message{Type: hdr, Fields: fields}
@@ -138,6 +149,12 @@ const (
 	InvalidIndicator = iota
 	GNSSS
 	DGPS
+	PPS
+	RTKFixed
+	RTKFloat
+	DeadReckoning
+	ManualInput
+	Simulation
 )
 
 type QualityIndicator int
@@ -150,6 +167,18 @@ func (s QualityIndicator) String() string {
 		return "GNSS fix"
 	case DGPS:
 		return "DGPS fix"
+	case PPS:
+		return "PPS fix"
+	case RTKFixed:
+		return "RTK fixed"
+	case RTKFloat:
+		return "RTK float"
+	case DeadReckoning:
+		return "Estimated (dead reckoning)"
+	case ManualInput:
+		return "Manual input"
+	case Simulation:
+		return "Simulation"
 	default:
 		return "unknow"
 
@@ -164,7 +193,7 @@ func ParseQualityIndicator(raw string) (qi QualityIndicator, err error) {
 
 	qi = QualityIndicator(i)
 	switch qi {
-	case InvalidIndicator, GNSSS, DGPS:
+	case InvalidIndicator, GNSSS, DGPS, PPS, RTKFixed, RTKFloat, DeadReckoning, ManualInput, Simulation:
 	default:
 		err = fmt.Errorf("unknow value")
 	}
@@ -2,12 +2,12 @@ package nmea
 
 import (
 	"fmt"
-	"strings"
 	"time"
 )
 
 // Examples:
 // $GPGLL,3110.2908,N,12123.2348,E,041139.000,A,A*59
+// $GPGLL,4916.45,N,12311.12,W,225444,A*31
 
 func NewGPGLL(m Message) *GPGLL {
 	return &GPGLL{Message: m}
@@ -16,38 +16,63 @@ func NewGPGLL(m Message) *GPGLL {
 type GPGLL struct {
 	Message
 
-	TimeUTC             time.Time // Aggregation of TimeUTC data field
-	Latitude, Longitude LatLong   // In decimal format
-	IsValid             DataValid
-	PositioningMode     PositioningMode
+	TimeUTC           time.Time // Aggregation of TimeUTC data field, zero if not available
+	TimeDigits        int       // Number of fractional digits of TimeUTC data field
+	Latitude          Latitude  // In decimal format
+	Longitude         Longitude // In decimal format
+	LatitudeDecimals  *int      // Number of decimals of minutes in latitude data field (NoCoordinate if empty), DefaultCoordinateDecimals if not specified (nil)
+	LongitudeDecimals *int      // Number of decimals of minutes in longitude data field (NoCoordinate if empty), DefaultCoordinateDecimals if not specified (nil)
+	IsValid           DataValid
+	PositioningMode   PositioningMode
 }
 
 func (m *GPGLL) parse() (err error) {
-	if len(m.Fields) != 7 {
-		return m.Error(fmt.Errorf("Incomplete GPGLL message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 7))
+	if len(m.Fields) != 6 && len(m.Fields) != 7 {
+		return m.Error(fmt.Errorf("Incomplete GPGLL message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 6, 7))
 	}
 
-	if latitude := strings.TrimSpace(strings.Join(m.Fields[0:2], " ")); len(latitude) > 0 {
-		if m.Latitude, err = NewLatLong(latitude); err != nil {
-			return m.Error(err)
-		}
+	if m.Latitude, m.LatitudeDecimals, err = parseLatitudeField(m.Fields[0], m.Fields[1]); err != nil {
+		return m.Error(err)
 	}
 
-	if longitude := strings.TrimSpace(strings.Join(m.Fields[2:4], " ")); len(longitude) > 0 {
-		if m.Longitude, err = NewLatLong(longitude); err != nil {
-			return m.Error(err)
-		}
+	if m.Longitude, m.LongitudeDecimals, err = parseLongitudeField(m.Fields[2], m.Fields[3]); err != nil {
+		return m.Error(err)
 	}
 
-	if m.TimeUTC, err = time.Parse("150405.000", m.Fields[4]); err != nil {
+	if m.TimeUTC, m.TimeDigits, err = parseTimeOfDay(m.Fields[4]); err != nil {
 		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[4]))
 	}
 
 	m.IsValid = (m.Fields[5] == "A")
 
-	if m.PositioningMode, err = ParsePositioningMode(m.Fields[6]); err != nil {
-		return m.Error(fmt.Errorf("Unable to parse GPS positioning mode from data field (got: %s)", m.Fields[6]))
+	// Positioning mode only since NMEA 2.3
+	if len(m.Fields) == 7 {
+		if m.PositioningMode, err = ParsePositioningMode(m.Fields[6]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse GPS positioning mode from data field (got: %s)", m.Fields[6]))
+		}
 	}
 
 	return nil
 }
+
+func (m GPGLL) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("GLL")
+	fields := make([]string, 0)
+
+	fields = append(fields, serializeDMField(m.Latitude.LatLong(), true, m.LatitudeDecimals)...)
+	fields = append(fields, serializeDMField(m.Longitude.LatLong(), false, m.LongitudeDecimals)...)
+	fields = append(fields,
+		serializeTimeOfDay(m.TimeUTC, m.TimeDigits),
+		m.IsValid.Serialize(),
+	)
+
+	if len(m.PositioningMode) > 0 || len(m.Fields) != 6 {
+		fields = append(fields, m.PositioningMode.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -7,6 +7,7 @@ import (
 
 // Examples:
 // $GPGSA,A,3,14,06,16,31,23,,,,,,,,1.66,1.42,0.84*0F
+// $GNGSA,A,3,80,71,73,79,69,,,,,,,,1.83,1.09,1.47,2*09
 
 func NewGPGSA(m Message) *GPGSA {
 	return &GPGSA{Message: m}
@@ -19,11 +20,12 @@ type GPGSA struct {
 	FixStatus              FixStatus
 	SatelliteUsedOnChannel [13]int // Note: index 0 not used (channel 1..12)
 	PDOP, HDOP, VDOP       float64
+	SystemID               SystemID // GNSS system of satellites used (NMEA 4.10 and later), 0 if not output
 }
 
 func (m *GPGSA) parse() (err error) {
-	if len(m.Fields) != 17 {
-		return m.Error(fmt.Errorf("Incomplete GPGSA message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 17))
+	if len(m.Fields) != 17 && len(m.Fields) != 18 {
+		return m.Error(fmt.Errorf("Incomplete GPGSA message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 17, 18))
 	}
 
 	if m.Mode, err = ParseMode(m.Fields[0]); err != nil {
@@ -59,6 +61,12 @@ func (m *GPGSA) parse() (err error) {
 		}
 	}
 
+	if len(m.Fields) == 18 && len(m.Fields[17]) > 0 {
+		if m.SystemID, err = ParseSystemID(m.Fields[17]); err != nil {
+			return m.Error(err)
+		}
+	}
+
 	return nil
 }
 
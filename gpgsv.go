@@ -10,6 +10,7 @@ import (
 // $GPGSV,3,1,12,01,05,060,18,02,17,259,43,04,56,287,28,09,08,277,28*77
 // $GPGSV,3,2,12,10,34,195,46,13,08,125,45,17,67,014,,20,32,048,24*74
 // $GPGSV,3,3,12,23,13,094,48,24,04,292,24,28,49,178,46,32,06,037,22*7D
+// $GAGSV,1,1,03,02,38,077,41,08,21,152,37,30,54,301,44,7*45
 
 func NewGPGSV(m Message) *GPGSV {
 	return &GPGSV{Message: m}
@@ -64,10 +65,11 @@ type GPGSV struct {
 	SequenceNumber   int // Sequence number of this entry (1 ~ 3)
 	SatellitesInView int
 	Satellites       []Satellite
+	SignalID         *int // GNSS signal of satellites in view (NMEA 4.10 and later, ie: 1 for GPS L1 C/A), nil if not output
 }
 
 func (m *GPGSV) parse() (err error) {
-	if len(m.Fields) < 3 || (len(m.Fields)-3)%4 != 0 {
+	if len(m.Fields) < 3 || (len(m.Fields)-3)%4 > 1 {
 		return m.Error(fmt.Errorf("Invalid message size (got: %d)", len(m.Fields)))
 	}
 
@@ -91,17 +93,32 @@ func (m *GPGSV) parse() (err error) {
 		return m.Error(err)
 	}
 
+	fields := m.Fields
+	if (len(fields)-3)%4 == 1 {
+		signal := fields[len(fields)-1]
+		fields = fields[:len(fields)-1]
+
+		if len(signal) > 0 {
+			var id int64
+			if id, err = strconv.ParseInt(signal, 16, 0); err != nil {
+				return m.Error(fmt.Errorf("Unable to parse signal ID from data field (got: %s)", signal))
+			}
+			signalID := int(id)
+			m.SignalID = &signalID
+		}
+	}
+
 	if m.SatellitesInView > 0 {
 		offset := 3
 		padding := 4
 		m.Satellites = make([]Satellite, 0)
 
-		for len(m.Fields[offset:]) != 0 {
-			if len(m.Fields[offset:]) < padding {
-				return m.Error(fmt.Errorf("Wrong number of satellite data (got: %d)", len(m.Fields[offset:])))
+		for len(fields[offset:]) != 0 {
+			if len(fields[offset:]) < padding {
+				return m.Error(fmt.Errorf("Wrong number of satellite data (got: %d)", len(fields[offset:])))
 			}
 
-			sat, err := newSatelliteFromFields(m.Fields[offset : offset+padding])
+			sat, err := newSatelliteFromFields(fields[offset : offset+padding])
 			if err != nil {
 				return m.Error(err)
 			}
@@ -120,7 +137,7 @@ func (m *GPGSV) parse() (err error) {
 }
 
 func (m GPGSV) Serialize() string { // Implement NMEA interface
-	hdr := TypeIDs["GPGSV"]
+	hdr := m.header("GSV")
 	fields := make([]string, 0)
 
 	fields = append(fields,
@@ -151,6 +168,12 @@ func (m GPGSV) Serialize() string { // Implement NMEA interface
 		}
 	}
 
+	if m.SignalID != nil {
+		fields = append(fields, strings.ToUpper(strconv.FormatInt(int64(*m.SignalID), 16)))
+	} else if len(m.Fields) > 3 && (len(m.Fields)-3)%4 == 1 {
+		fields = append(fields, "")
+	}
+
 	msg := Message{Type: hdr, Fields: fields}
 	msg.Checksum = msg.ComputeChecksum()
 
@@ -3,7 +3,6 @@ package nmea
 import (
 	"fmt"
 	"strconv"
-	"strings"
 	"time"
 )
 
@@ -20,37 +19,49 @@ func NewGPRMC(m Message) *GPRMC {
 type GPRMC struct {
 	Message
 
-	DateTimeUTC       time.Time // Aggregation of TimeUTC+Date data field
+	DateTimeUTC       time.Time // Aggregation of TimeUTC+Date data field, zero if not available
+	TimeDigits        int       // Number of fractional digits of TimeUTC data field
 	IsValid           DataValid // 'V' =Invalid / 'A' = Valid
-	Latitude          LatLong   // In decimal format
-	Longitude         LatLong   // In decimal format
+	Latitude          Latitude  // In decimal format
+	Longitude         Longitude // In decimal format
+	LatitudeDecimals  *int      // Number of decimals of minutes in latitude data field (NoCoordinate if empty), DefaultCoordinateDecimals if not specified (nil)
+	LongitudeDecimals *int      // Number of decimals of minutes in longitude data field (NoCoordinate if empty), DefaultCoordinateDecimals if not specified (nil)
 	Speed             float64   // Speed over ground in knots
 	COG               float64   // Course over ground in degree
 	MagneticVariation float64   // Magnetic variation in degree, not being output
 	PositioningMode   PositioningMode
+	Status            NavigationalStatus // NMEA 4.1 and later, empty if not available
 }
 
 func (m *GPRMC) parse() (err error) {
-	if len(m.Fields) != 12 {
-		return m.Error(fmt.Errorf("Incomplete GPRMC message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 12))
+	if len(m.Fields) < 11 || len(m.Fields) > 13 {
+		return m.Error(fmt.Errorf("Incomplete GPRMC message, not enougth data fields (got: %d, wanted: %d ~ %d)", len(m.Fields), 11, 13))
 	}
 
-	datetime := fmt.Sprintf("%s %s", m.Fields[8], m.Fields[0])
-	if m.DateTimeUTC, err = time.Parse("020106 150405.000", datetime); err != nil {
-		return m.Error(fmt.Errorf("Unable to parse datetime UTC from data field (got: %s)", datetime))
+	timeUTC, digits, err := parseTimeOfDay(m.Fields[0])
+	if err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[0]))
+	}
+	m.TimeDigits = digits
+
+	if date := m.Fields[8]; len(date) > 0 {
+		if m.DateTimeUTC, err = time.Parse("020106", date); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse date UTC from data field (got: %s)", date))
+		}
+		if !timeUTC.IsZero() {
+			m.DateTimeUTC = m.DateTimeUTC.Add(timeUTC.Sub(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)))
+		}
+	} else if !timeUTC.IsZero() {
+		m.DateTimeUTC = timeUTC
 	}
 
 	m.IsValid = (m.Fields[1] == "A")
 
-	if latitude := strings.TrimSpace(strings.Join(m.Fields[2:4], " ")); len(latitude) > 0 {
-		if m.Latitude, err = NewLatLong(latitude); err != nil {
-			return m.Error(err)
-		}
+	if m.Latitude, m.LatitudeDecimals, err = parseLatitudeField(m.Fields[2], m.Fields[3]); err != nil {
+		return m.Error(err)
 	}
-	if longitude := strings.TrimSpace(strings.Join(m.Fields[4:6], " ")); len(longitude) > 0 {
-		if m.Longitude, err = NewLatLong(longitude); err != nil {
-			return m.Error(err)
-		}
+	if m.Longitude, m.LongitudeDecimals, err = parseLongitudeField(m.Fields[4], m.Fields[5]); err != nil {
+		return m.Error(err)
 	}
 
 	if m.Speed, err = strconv.ParseFloat(m.Fields[6], 64); err != nil {
@@ -83,9 +94,70 @@ func (m *GPRMC) parse() (err error) {
 		}
 	}
 
-	if m.PositioningMode, err = ParsePositioningMode(m.Fields[11]); err != nil {
-		return m.Error(fmt.Errorf("Unable to parse GPS positioning mode from data field (got: %s)", m.Fields[11]))
+	// Positioning mode only since NMEA 2.3
+	if len(m.Fields) > 11 {
+		if m.PositioningMode, err = ParsePositioningMode(m.Fields[11]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse GPS positioning mode from data field (got: %s)", m.Fields[11]))
+		}
+	}
+
+	if len(m.Fields) > 12 && len(m.Fields[12]) > 0 {
+		if m.Status, err = ParseNavigationalStatus(m.Fields[12]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse navigational status from data field (got: %s)", m.Fields[12]))
+		}
 	}
 
 	return nil
 }
+
+func (m GPRMC) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("RMC")
+	fields := make([]string, 0)
+
+	// Midnight of a date without time of day is kept empty
+	if len(m.Fields) > 0 && len(m.Fields[0]) == 0 && m.DateTimeUTC.Equal(m.DateTimeUTC.Truncate(24*time.Hour)) {
+		fields = append(fields, "")
+	} else {
+		fields = append(fields, serializeTimeOfDay(m.DateTimeUTC, m.TimeDigits))
+	}
+
+	fields = append(fields, m.IsValid.Serialize())
+	fields = append(fields, serializeDMField(m.Latitude.LatLong(), true, m.LatitudeDecimals)...)
+	fields = append(fields, serializeDMField(m.Longitude.LatLong(), false, m.LongitudeDecimals)...)
+	fields = append(fields,
+		formatFloat(m.Speed, m.field(6), "%.2f"),
+		formatFloat(m.COG, m.field(7), "%.2f"),
+	)
+
+	if m.DateTimeUTC.IsZero() || m.DateTimeUTC.Year() == 0 {
+		fields = append(fields, "")
+	} else {
+		fields = append(fields, m.DateTimeUTC.Format("020106"))
+	}
+
+	if m.hasMagneticVariation() {
+		fields = append(fields, serializeEastWest(&m.MagneticVariation, m.field(9), m.field(10))...)
+	} else {
+		fields = append(fields, "", "")
+	}
+
+	if len(m.PositioningMode) > 0 || len(m.Fields) != 11 {
+		fields = append(fields, m.PositioningMode.Serialize())
+	}
+
+	// Navigational status only since NMEA 4.1
+	if len(m.Status) > 0 || len(m.Fields) == 13 {
+		fields = append(fields, m.Status.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// hasMagneticVariation return true when magnetic variation is output (data field not empty) or set (ie: filled from magnetic model)
+func (m GPRMC) hasMagneticVariation() bool {
+	return len(m.field(9)) > 0 || m.MagneticVariation != 0
+}
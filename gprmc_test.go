@@ -0,0 +1,80 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRTKModes(t *testing.T) {
+	raw := "$GPRMC,013732.000,A,3150.7238,N,11711.7278,E,0.00,0.00,220413,,,F,C*00"
+	msg, err := Parse(raw)
+	if err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+	}
+
+	rmc := msg.(*GPRMC)
+	if rmc.PositioningMode != FloatRTKFix || rmc.Status != CautionStatus {
+		t.Fatalf("Wrong mode or status (got: %s, %s)", rmc.PositioningMode, rmc.Status)
+	}
+	if rmc.PositioningMode.String() != "Float RTK fix" || rmc.Status.String() != "Caution" {
+		t.Fatalf("Wrong mode or status names (got: %s, %s)", rmc.PositioningMode, rmc.Status)
+	}
+
+	// Navigational status is output once set
+	crafted := GPRMC{IsValid: Valid, Latitude: 31.845397, Longitude: 117.19546333, PositioningMode: FloatRTKFix, Status: SafeStatus}
+	if expected := "$GPRMC,,A,3150.7238,N,11711.7278,E,0.00,0.00,,,,F,S*0C"; crafted.Serialize() != expected {
+		t.Fatalf("Wrong serialized message (got: %s, wanted: %s)", crafted.Serialize(), expected)
+	}
+
+	raw = "$GPGGA,015540.000,3150.68378,N,11711.93139,E,4,17,0.6,0051.6,M,0.0,M,,*5D"
+	if msg, err = Parse(raw); err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+	}
+	if gga := msg.(*GPGGA); gga.QualityIndicator != RTKFixed || gga.QualityIndicator.String() != "RTK fixed" {
+		t.Fatalf("Wrong quality indicator (got: %d, %s)", gga.QualityIndicator, gga.QualityIndicator)
+	}
+
+	// RTK output carries age of corrections and reference station
+	raw = "$GNGGA,101520.00,4807.03812,N,01131.00031,E,4,12,0.62,519.4,M,47.6,M,1.2,0031*67"
+	if msg, err = Parse(raw); err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+	}
+	gga := msg.(*GPGGA)
+	if gga.DGPSAge == nil || *gga.DGPSAge != 1.2 || gga.DGPSStationID != "0031" {
+		t.Fatalf("Wrong differential corrections (got: %v, %s)", gga.DGPSAge, gga.DGPSStationID)
+	}
+
+	// Altitude below mean sea level
+	gga.Fields, gga.Altitude = nil, -12.5
+	if s := gga.Serialize(); s != "$GNGGA,101520.00,4807.03812,N,01131.00031,E,4,12,0.6,-12.5,M,47.6,M,1.2,0031*47" {
+		t.Fatalf("Wrong serialized message (got: %s)", s)
+	}
+
+	for i := InvalidIndicator; i <= Simulation; i++ {
+		if qi, err := ParseQualityIndicator(string(rune('0' + i))); err != nil || qi.String() == "unknow" {
+			t.Fatalf("Quality indicator %d should be supported", i)
+		}
+	}
+	if _, err := ParseQualityIndicator("9"); err == nil {
+		t.Fatal("Quality indicator 9 should be rejected")
+	}
+
+	for _, mode := range []string{"A", "D", "E", "F", "M", "N", "P", "R", "S"} {
+		if pm, err := ParsePositioningMode(mode); err != nil || pm.String() == "unknow" {
+			t.Fatalf("Positioning mode %s should be supported", mode)
+		}
+	}
+
+	// NMEA 2.x sentence without positioning mode nor fractional seconds
+	raw = "$GPRMC,081836,A,3751.65,S,14507.36,E,000.0,360.0,130998,011.3,E*62"
+	if msg, err = Parse(raw); err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+	}
+	rmc = msg.(*GPRMC)
+	if !rmc.DateTimeUTC.Equal(time.Date(1998, time.September, 13, 8, 18, 36, 0, time.UTC)) || rmc.TimeDigits != 0 || len(rmc.PositioningMode) != 0 {
+		t.Fatalf("Wrong NMEA 2.x fix (got: %s, %d digits, mode %s)", rmc.DateTimeUTC, rmc.TimeDigits, rmc.PositioningMode)
+	}
+	if rmc.Serialize() != raw {
+		t.Fatalf("Wrong serialized message (got: %s, wanted: %s)", rmc.Serialize(), raw)
+	}
+}
@@ -0,0 +1,137 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Examples:
+// $HCHDG,98.3,0.0,E,12.6,W*57
+// $HCHDG,238.5,,,,*4E
+
+func NewHDG(m Message) *HDG {
+	return &HDG{Message: m}
+}
+
+type HDG struct {
+	Message
+
+	Heading   *float64 // Magnetic sensor heading in degree, empty when data is not valid
+	Deviation *float64 // Magnetic deviation in degree (East is positive, West is negative), empty if unknown
+	Variation *float64 // Magnetic variation in degree (East is positive, West is negative), empty if unknown
+}
+
+func (m *HDG) parse() (err error) {
+	if len(m.Fields) != 5 {
+		return m.Error(fmt.Errorf("Incomplete HDG message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 5))
+	}
+
+	if m.Heading, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse magnetic sensor heading from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.Deviation, err = parseEastWest(m.Fields[1], m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse magnetic deviation from data field (got: %s,%s)", m.Fields[1], m.Fields[2]))
+	}
+
+	if m.Variation, err = parseEastWest(m.Fields[3], m.Fields[4]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse magnetic variation from data field (got: %s,%s)", m.Fields[3], m.Fields[4]))
+	}
+
+	return nil
+}
+
+func (m HDG) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("HDG")
+	fields := make([]string, 0)
+
+	fields = append(fields, formatOptionalFloat(m.Heading, m.field(0), "%.1f"))
+	fields = append(fields, serializeEastWest(m.Deviation, m.field(1), m.field(2))...)
+	fields = append(fields, serializeEastWest(m.Variation, m.field(3), m.field(4))...)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// MagneticHeading return sensor heading corrected by deviation (if any), nil if heading is unknown
+func (m HDG) MagneticHeading() *float64 {
+	if m.Heading == nil {
+		return nil
+	}
+	h := *m.Heading
+	if m.Deviation != nil {
+		h += *m.Deviation
+	}
+	h = normalizeDegrees(h)
+	return &h
+}
+
+// TrueHeading return magnetic heading corrected by variation, nil if heading or variation is unknown
+func (m HDG) TrueHeading() *float64 {
+	magnetic := m.MagneticHeading()
+	if magnetic == nil || m.Variation == nil {
+		return nil
+	}
+	h := normalizeDegrees(*magnetic + *m.Variation)
+	return &h
+}
+
+// parseEastWest return signed value from degree and direction data fields (West is negative like GPRMC magnetic variation)
+func parseEastWest(value, direction string) (*float64, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+
+	v, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(direction) > 0 {
+		dir, err := ParseCardinalPoint(direction)
+		if err != nil {
+			return nil, err
+		}
+
+		switch dir {
+		case West:
+			v = 0 - v
+		case East:
+			// Allowed direction
+		default:
+			return nil, fmt.Errorf("Wrong direction (got: %s)", direction)
+		}
+	}
+
+	return &v, nil
+}
+
+// serializeEastWest return degree and direction data fields from signed value, with precision of the parsed
+// data field (see formatFloat) and its direction kept empty when omitted by the talker for an unchanged value
+func serializeEastWest(v *float64, raw, direction string) []string {
+	if v == nil {
+		return []string{"", ""}
+	}
+
+	if *v < 0 {
+		return []string{formatFloat(0-*v, raw, "%.1f"), West.String()}
+	}
+
+	value := formatFloat(*v, raw, "%.1f")
+	if len(direction) == 0 && len(raw) > 0 && value == raw {
+		return []string{value, ""}
+	}
+	return []string{value, East.String()}
+}
+
+// normalizeDegrees return angle in range [0, 360)
+func normalizeDegrees(v float64) float64 {
+	if v = math.Mod(v, 360); v < 0 {
+		v += 360
+	}
+	return v
+}
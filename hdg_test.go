@@ -0,0 +1,52 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHDG(t *testing.T) {
+	raw := "$HCHDG,98.3,0.0,E,12.6,W*57"
+
+	msg, err := Parse(raw)
+	if err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+	}
+
+	hdg, ok := msg.(*HDG)
+	if !ok {
+		t.Fatalf("Wrong message type (got: %T)", msg)
+	}
+
+	if hdg.GetMessage().Type.GetTypeID().Talker != TalkerIDHC {
+		t.Fatalf("Wrong talker (got: %s)", hdg.GetMessage().Type.GetTypeID().Talker)
+	}
+
+	if hdg.Variation == nil || *hdg.Variation != -12.6 {
+		t.Fatalf("Wrong magnetic variation, West should be negative (got: %v)", hdg.Variation)
+	}
+
+	trueHeading := hdg.TrueHeading()
+	if trueHeading == nil || math.Abs(*trueHeading-85.7) > 1e-9 {
+		t.Fatalf("Wrong true heading (got: %v, expected: %f)", trueHeading, 85.7)
+	}
+
+	// Heading and deviation without direction are kept empty, unlike a crafted one
+	raw = "$HCHDG,,0.5,,,*47"
+	if msg, err = Parse(raw); err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+	}
+	hdg = msg.(*HDG)
+	if hdg.Heading != nil || hdg.MagneticHeading() != nil || hdg.TrueHeading() != nil {
+		t.Fatalf("Heading should be unknown (got: %v)", hdg.Heading)
+	}
+	if hdg.Serialize() != raw {
+		t.Fatalf("Wrong serialized message (got: %s, wanted: %s)", hdg.Serialize(), raw)
+	}
+
+	heading, deviation := 98.3, 0.5
+	crafted := HDG{Heading: &heading, Deviation: &deviation}
+	if expected := "$GPHDG,98.3,0.5,E,,*02"; crafted.Serialize() != expected {
+		t.Fatalf("Wrong serialized message (got: %s, wanted: %s)", crafted.Serialize(), expected)
+	}
+}
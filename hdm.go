@@ -0,0 +1,47 @@
+package nmea
+
+import (
+	"fmt"
+)
+
+// Examples:
+// $HCHDM,238.5,M*25
+
+func NewHDM(m Message) *HDM {
+	return &HDM{Message: m}
+}
+
+type HDM struct {
+	Message
+
+	Heading *float64 // Heading (magnetic) in degree, empty when data is not valid
+}
+
+func (m *HDM) parse() (err error) {
+	if len(m.Fields) != 2 {
+		return m.Error(fmt.Errorf("Incomplete HDM message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 2))
+	}
+
+	if m.Fields[1] != "M" {
+		return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", 2, m.Fields[1], "M"))
+	}
+
+	if m.Heading, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse magnetic heading from data field (got: %s)", m.Fields[0]))
+	}
+
+	return nil
+}
+
+func (m HDM) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("HDM")
+	fields := make([]string, 0)
+
+	fields = append(fields, formatOptionalFloat(m.Heading, m.field(0), "%.1f"), "M")
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
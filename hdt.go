@@ -0,0 +1,47 @@
+package nmea
+
+import (
+	"fmt"
+)
+
+// Examples:
+// $HEHDT,274.1,T*2F
+
+func NewHDT(m Message) *HDT {
+	return &HDT{Message: m}
+}
+
+type HDT struct {
+	Message
+
+	Heading *float64 // Heading (true) in degree, empty when data is not valid
+}
+
+func (m *HDT) parse() (err error) {
+	if len(m.Fields) != 2 {
+		return m.Error(fmt.Errorf("Incomplete HDT message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 2))
+	}
+
+	if m.Fields[1] != "T" {
+		return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", 2, m.Fields[1], "T"))
+	}
+
+	if m.Heading, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse true heading from data field (got: %s)", m.Fields[0]))
+	}
+
+	return nil
+}
+
+func (m HDT) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("HDT")
+	fields := make([]string, 0)
+
+	fields = append(fields, formatOptionalFloat(m.Heading, m.field(0), "%.1f"), "T")
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -3,6 +3,9 @@ package nmea
 import (
 	"fmt"
 	"math"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // PrependXZero return string with expected number of zero (as prefix)
@@ -36,3 +39,75 @@ func Round(value float64, expected int, threshold float64) float64 {
 	}
 	return math.Floor(digit) / pow
 }
+
+// parseOptionalFloat return nil if data field is empty
+func parseOptionalFloat(raw string) (*float64, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+
+	v, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+// parseTimeOfDay parse hhmmss[.sss] data field with any number of fractional digits,
+// time is zero when data field is empty
+func parseTimeOfDay(raw string) (t time.Time, digits int, err error) {
+	if len(raw) == 0 {
+		return
+	}
+
+	if t, err = time.Parse("150405", raw); err != nil {
+		return
+	}
+
+	if i := strings.Index(raw, "."); i >= 0 {
+		digits = len(raw) - i - 1
+	}
+	return
+}
+
+// serializeTimeOfDay return hhmmss data field with expected number of fractional digits,
+// empty data field if time is zero
+func serializeTimeOfDay(t time.Time, digits int) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	layout := "150405"
+	if digits > 0 {
+		if digits > 9 {
+			digits = 9 // Nanosecond precision
+		}
+		layout += "." + strings.Repeat("0", digits)
+	}
+	return t.Format(layout)
+}
+
+// field return data field at index of the parsed message, empty if not available (ie: message crafted in code)
+func (m Message) field(i int) string {
+	if i < 0 || i >= len(m.Fields) {
+		return ""
+	}
+	return m.Fields[i]
+}
+
+// formatFloat return the parsed data field when it still holds the same value to preserve its precision
+// (ie: "274.12" or "011.3"), otherwise value formatted with formatString
+func formatFloat(v float64, raw string, formatString string) string {
+	if parsed, err := strconv.ParseFloat(raw, 64); err == nil && parsed == v {
+		return raw
+	}
+	return fmt.Sprintf(formatString, v)
+}
+
+// formatOptionalFloat return empty data field if value is nil (see formatFloat)
+func formatOptionalFloat(v *float64, raw string, formatString string) string {
+	if v == nil {
+		return ""
+	}
+	return formatFloat(*v, raw, formatString)
+}
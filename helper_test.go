@@ -0,0 +1,37 @@
+package nmea
+
+import (
+	"testing"
+	"time"
+)
+
+func TestTimeOfDay(t *testing.T) {
+	for raw, expected := range map[string]struct {
+		time   time.Time
+		digits int
+	}{
+		"081836":      {time.Date(0, 1, 1, 8, 18, 36, 0, time.UTC), 0},
+		"081836.5":    {time.Date(0, 1, 1, 8, 18, 36, 500000000, time.UTC), 1},
+		"123519.00":   {time.Date(0, 1, 1, 12, 35, 19, 0, time.UTC), 2},
+		"015540.000":  {time.Date(0, 1, 1, 1, 55, 40, 0, time.UTC), 3},
+		"015540.1234": {time.Date(0, 1, 1, 1, 55, 40, 123400000, time.UTC), 4},
+		"":            {time.Time{}, 0},
+	} {
+		tod, digits, err := parseTimeOfDay(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		if !tod.Equal(expected.time) || digits != expected.digits {
+			t.Fatalf("Wrong time of day for \"%s\" (got: %s with %d digits, wanted: %s with %d digits)", raw, tod, digits, expected.time, expected.digits)
+		}
+		if out := serializeTimeOfDay(tod, digits); out != raw {
+			t.Fatalf("Wrong serialization (got: %s, wanted: %s)", out, raw)
+		}
+	}
+
+	for _, invalid := range []string{"0818", "251836", "08:18:36", "081836.x"} {
+		if _, _, err := parseTimeOfDay(invalid); err == nil {
+			t.Fatalf("Time of day \"%s\" should be rejected", invalid)
+		}
+	}
+}
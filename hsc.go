@@ -0,0 +1,58 @@
+package nmea
+
+import "fmt"
+
+// Examples:
+// $APHSC,040.0,T,041.5,M*54
+// $APHSC,,T,041.5,M*7E
+
+func NewHSC(m Message) *HSC {
+	return &HSC{Message: m}
+}
+
+// HSC is the heading steering command
+type HSC struct {
+	Message
+
+	HeadingTrue     *float64 // Commanded heading (true) in degree, empty if not available
+	HeadingMagnetic *float64 // Commanded heading (magnetic) in degree, empty if not available
+}
+
+func (m *HSC) parse() (err error) {
+	if len(m.Fields) != 4 {
+		return m.Error(fmt.Errorf("Incomplete HSC message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 4))
+	}
+
+	// Validate fixed field
+	for i, v := range map[int]string{1: "T", 3: "M"} {
+		if m.Fields[i] != v {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], v))
+		}
+	}
+
+	if m.HeadingTrue, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse true heading from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.HeadingMagnetic, err = parseOptionalFloat(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse magnetic heading from data field (got: %s)", m.Fields[2]))
+	}
+
+	return nil
+}
+
+func (m HSC) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("HSC")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		serializeOptionalDegrees(m.HeadingTrue), "T",
+		serializeOptionalDegrees(m.HeadingMagnetic), "M",
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
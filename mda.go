@@ -0,0 +1,100 @@
+package nmea
+
+import "fmt"
+
+// Examples:
+// $IIMDA,30.12,I,1.020,B,22.5,C,17.8,C,64.2,,14.1,C,210.0,T,208.5,M,12.4,N,6.4,M*2F
+// $IIMDA,,I,,B,,C,17.8,C,,,,C,,T,,M,,N,,M*0A
+
+func NewMDA(m Message) *MDA {
+	return &MDA{Message: m}
+}
+
+// MDA is the meteorological composite sentence, each value is empty if not available
+type MDA struct {
+	Message
+
+	PressureInches        *float64 // Barometric pressure in inches of mercury
+	PressureBars          *float64 // Barometric pressure in bars
+	AirTemperature        *float64 // Air temperature in degree Celsius
+	WaterTemperature      *float64 // Water temperature in degree Celsius
+	RelativeHumidity      *float64 // Relative humidity in percent
+	AbsoluteHumidity      *float64 // Absolute humidity in percent
+	DewPoint              *float64 // Dew point in degree Celsius
+	WindDirectionTrue     *float64 // Wind direction (true) in degree
+	WindDirectionMagnetic *float64 // Wind direction (magnetic) in degree
+	WindSpeedKnots        *float64 // Wind speed in knots
+	WindSpeedMs           *float64 // Wind speed in m/s
+}
+
+func (m *MDA) parse() (err error) {
+	if len(m.Fields) != 20 {
+		return m.Error(fmt.Errorf("Incomplete MDA message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 20))
+	}
+
+	// Validate fixed field
+	for i, v := range map[int]string{1: "I", 3: "B", 5: "C", 7: "C", 11: "C", 13: "T", 15: "M", 17: "N", 19: "M"} {
+		if m.Fields[i] != v {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], v))
+		}
+	}
+
+	for i, v := range map[int]**float64{
+		0:  &m.PressureInches,
+		2:  &m.PressureBars,
+		4:  &m.AirTemperature,
+		6:  &m.WaterTemperature,
+		8:  &m.RelativeHumidity,
+		9:  &m.AbsoluteHumidity,
+		10: &m.DewPoint,
+		12: &m.WindDirectionTrue,
+		14: &m.WindDirectionMagnetic,
+		16: &m.WindSpeedKnots,
+		18: &m.WindSpeedMs,
+	} {
+		if *v, err = parseOptionalFloat(m.Fields[i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field at %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	return nil
+}
+
+func (m MDA) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("MDA")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		formatOptionalFloat(m.PressureInches, m.field(0), "%.2f"), "I",
+		formatOptionalFloat(m.PressureBars, m.field(2), "%.3f"), "B",
+		formatOptionalFloat(m.AirTemperature, m.field(4), "%.1f"), "C",
+		formatOptionalFloat(m.WaterTemperature, m.field(6), "%.1f"), "C",
+		formatOptionalFloat(m.RelativeHumidity, m.field(8), "%.1f"),
+		formatOptionalFloat(m.AbsoluteHumidity, m.field(9), "%.1f"),
+		formatOptionalFloat(m.DewPoint, m.field(10), "%.1f"), "C",
+		formatOptionalFloat(m.WindDirectionTrue, m.field(12), "%.1f"), "T",
+		formatOptionalFloat(m.WindDirectionMagnetic, m.field(14), "%.1f"), "M",
+		formatOptionalFloat(m.WindSpeedKnots, m.field(16), "%.1f"), "N",
+		formatOptionalFloat(m.WindSpeedMs, m.field(18), "%.1f"), "M",
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// Pressure return barometric pressure in pascal from the first available unit
+func (m MDA) Pressure() *float64 {
+	var v float64
+	switch {
+	case m.PressureBars != nil:
+		v = TransducerUnitBar.ToSI(*m.PressureBars)
+	case m.PressureInches != nil:
+		v = *m.PressureInches * InchOfMercuryInPascal
+	default:
+		return nil
+	}
+	return &v
+}
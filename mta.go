@@ -0,0 +1,49 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Examples:
+// $IIMTA,22.5,C*00
+
+func NewMTA(m Message) *MTA {
+	return &MTA{Message: m}
+}
+
+// MTA is the air temperature sentence (to be phased out, see MDA or XDR)
+type MTA struct {
+	Message
+
+	Temperature float64 // Air temperature in degree Celsius
+}
+
+func (m *MTA) parse() (err error) {
+	if len(m.Fields) != 2 {
+		return m.Error(fmt.Errorf("Incomplete MTA message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 2))
+	}
+
+	if m.Fields[1] != "C" {
+		return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", 2, m.Fields[1], "C"))
+	}
+
+	if m.Temperature, err = strconv.ParseFloat(m.Fields[0], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse air temperature from data field (got: %s)", m.Fields[0]))
+	}
+
+	return nil
+}
+
+func (m MTA) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("MTA")
+	fields := make([]string, 0)
+
+	fields = append(fields, formatFloat(m.Temperature, m.field(0), "%.1f"), "C")
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,48 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Examples:
+// $YXMTW,17.8,C*1C
+
+func NewMTW(m Message) *MTW {
+	return &MTW{Message: m}
+}
+
+type MTW struct {
+	Message
+
+	Temperature float64 // Water temperature in degree Celsius
+}
+
+func (m *MTW) parse() (err error) {
+	if len(m.Fields) != 2 {
+		return m.Error(fmt.Errorf("Incomplete MTW message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 2))
+	}
+
+	if m.Fields[1] != "C" {
+		return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", 2, m.Fields[1], "C"))
+	}
+
+	if m.Temperature, err = strconv.ParseFloat(m.Fields[0], 64); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse water temperature from data field (got: %s)", m.Fields[0]))
+	}
+
+	return nil
+}
+
+func (m MTW) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("MTW")
+	fields := make([]string, 0)
+
+	fields = append(fields, formatFloat(m.Temperature, m.field(0), "%.1f"), "C")
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
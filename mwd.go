@@ -0,0 +1,81 @@
+package nmea
+
+import "fmt"
+
+// Examples:
+// $WIMWD,10.1,T,10.1,M,12.0,N,6.2,M*6D
+// $WIMWD,,T,,M,,N,,M*5A
+
+func NewMWD(m Message) *MWD {
+	return &MWD{Message: m}
+}
+
+type MWD struct {
+	Message
+
+	DirectionTrue     *float64 // Wind direction (true) in degree, empty if not available
+	DirectionMagnetic *float64 // Wind direction (magnetic) in degree, empty if not available
+	SpeedKnots        *float64 // Wind speed in knots, empty if not available
+	SpeedMs           *float64 // Wind speed in m/s, empty if not available
+}
+
+func (m *MWD) parse() (err error) {
+	if len(m.Fields) != 8 {
+		return m.Error(fmt.Errorf("Incomplete MWD message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 8))
+	}
+
+	// Validate fixed field
+	for i, v := range map[int]string{1: "T", 3: "M", 5: "N", 7: "M"} {
+		if m.Fields[i] != v {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], v))
+		}
+	}
+
+	if m.DirectionTrue, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse true wind direction from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.DirectionMagnetic, err = parseOptionalFloat(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse magnetic wind direction from data field (got: %s)", m.Fields[2]))
+	}
+
+	if m.SpeedKnots, err = parseOptionalFloat(m.Fields[4]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse wind speed from data field (got: %s)", m.Fields[4]))
+	}
+
+	if m.SpeedMs, err = parseOptionalFloat(m.Fields[6]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse wind speed from data field (got: %s)", m.Fields[6]))
+	}
+
+	return nil
+}
+
+func (m MWD) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("MWD")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		formatOptionalFloat(m.DirectionTrue, m.field(0), "%.1f"), "T",
+		formatOptionalFloat(m.DirectionMagnetic, m.field(2), "%.1f"), "M",
+		formatOptionalFloat(m.SpeedKnots, m.field(4), "%.1f"), "N",
+		formatOptionalFloat(m.SpeedMs, m.field(6), "%.1f"), "M",
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// Speed return wind speed in m/s, computed from knots when only this one is provided
+func (m MWD) Speed() *float64 {
+	if m.SpeedMs != nil {
+		return m.SpeedMs
+	}
+	if m.SpeedKnots != nil {
+		v := SpeedUnitKnots.ToMetersPerSecond(*m.SpeedKnots)
+		return &v
+	}
+	return nil
+}
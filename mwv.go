@@ -0,0 +1,126 @@
+package nmea
+
+import "fmt"
+
+// Examples:
+// $WIMWV,214.8,R,0.1,K,A*28
+// $WIMWV,20.0,T,12.4,N,A*20
+// $WIMWV,,R,,N,V*34
+
+func NewMWV(m Message) *MWV {
+	return &MWV{Message: m}
+}
+
+type MWV struct {
+	Message
+
+	Angle     *float64 // Wind angle in degree (0 ~ 359), empty if not available
+	Reference WindReference
+	Speed     *float64 // Wind speed expressed in SpeedUnit, empty if not available
+	SpeedUnit SpeedUnit
+	IsValid   DataValid
+}
+
+func (m *MWV) parse() (err error) {
+	if len(m.Fields) != 5 {
+		return m.Error(fmt.Errorf("Incomplete MWV message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 5))
+	}
+
+	if m.Angle, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse wind angle from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.Reference, err = ParseWindReference(m.Fields[1]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse wind reference from data field (got: %s)", m.Fields[1]))
+	}
+
+	if m.Speed, err = parseOptionalFloat(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse wind speed from data field (got: %s)", m.Fields[2]))
+	}
+
+	if m.SpeedUnit, err = ParseSpeedUnit(m.Fields[3]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse wind speed unit from data field (got: %s)", m.Fields[3]))
+	}
+
+	m.IsValid = (m.Fields[4] == "A")
+
+	return nil
+}
+
+func (m MWV) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("MWV")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		formatOptionalFloat(m.Angle, m.field(0), "%.1f"),
+		m.Reference.Serialize(),
+		formatOptionalFloat(m.Speed, m.field(2), "%.1f"),
+		m.SpeedUnit.Serialize(),
+		m.IsValid.Serialize(),
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// SpeedKnots return wind speed converted to knots, nil if not available
+func (m MWV) SpeedKnots() *float64 {
+	if m.Speed == nil {
+		return nil
+	}
+	v := m.SpeedUnit.ToKnots(*m.Speed)
+	return &v
+}
+
+// SpeedMetersPerSecond return wind speed converted to m/s, nil if not available
+func (m MWV) SpeedMetersPerSecond() *float64 {
+	if m.Speed == nil {
+		return nil
+	}
+	v := m.SpeedUnit.ToMetersPerSecond(*m.Speed)
+	return &v
+}
+
+// SpeedKmh return wind speed converted to km/h, nil if not available
+func (m MWV) SpeedKmh() *float64 {
+	if m.Speed == nil {
+		return nil
+	}
+	v := m.SpeedUnit.ToKmh(*m.Speed)
+	return &v
+}
+
+const (
+	WindReferenceRelative    WindReference = "R" // Relative to the vessel (apparent wind)
+	WindReferenceTheoretical WindReference = "T" // Theoretical, calculated from vessel speed (true wind)
+)
+
+type WindReference string
+
+func (w WindReference) Serialize() string {
+	return string(w)
+}
+
+func (w WindReference) String() string {
+	switch w {
+	case WindReferenceRelative:
+		return "Relative"
+	case WindReferenceTheoretical:
+		return "Theoretical"
+	default:
+		return "unknow"
+	}
+}
+
+func ParseWindReference(raw string) (w WindReference, err error) {
+	w = WindReference(raw)
+	switch w {
+	case WindReferenceRelative, WindReferenceTheoretical:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
@@ -0,0 +1,40 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+)
+
+func TestMWVSpeedConversion(t *testing.T) {
+	samples := map[string]float64{ // raw => expected wind speed in knots
+		"$WIMWV,214.8,R,0.1,K,A*28": 0.1 / 1.852,
+		"$WIMWV,20.0,T,12.4,N,A*20": 12.4,
+	}
+
+	for raw, expected := range samples {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+
+		mwv, ok := msg.(*MWV)
+		if !ok {
+			t.Fatalf("Wrong message type (got: %T)", msg)
+		}
+
+		if knots := mwv.SpeedKnots(); knots == nil || math.Abs(*knots-expected) > 1e-9 {
+			t.Fatalf("Wrong wind speed for \"%s\" (got: %v, expected: %f)", raw, knots, expected)
+		}
+	}
+
+	if v := SpeedUnitKnots.ToKmh(10); math.Abs(v-18.52) > 1e-9 {
+		t.Fatalf("Wrong speed conversion from knots to km/h (got: %f)", v)
+	}
+
+	// Crafted legacy VWR is formatted like MWV
+	angle, speed := -45.0, 12.6
+	vwr := VWR{Angle: &angle, SpeedKnots: &speed}
+	if expected := "$GPVWR,45.0,L,12.6,N,,M,,K*44"; vwr.Serialize() != expected {
+		t.Fatalf("Wrong serialized message (got: %s, wanted: %s)", vwr.Serialize(), expected)
+	}
+}
@@ -62,6 +62,39 @@ func (m Message) ComputeChecksum() (c uint8) {
 	return
 }
 
+// header return the header of the parsed message or the default one (GPS talker) when crafting a new message
+func (m Message) header(code string) Header {
+	if m.Type != nil {
+		return m.Type
+	}
+	return TypeIDs[string(TalkerIDGPS)+code]
+}
+
+// lookupTalkerTypeID return header for a standard sentence emitted by another talker than GPS (ie: "HEHDT")
+// or for a query sentence (ie: "CCGPQ")
+func lookupTalkerTypeID(raw string) (Header, bool) {
+	if len(raw) != 5 {
+		return nil, false
+	}
+
+	talker, code := TalkerID(raw[:2]), raw[2:]
+	if _, ok := TalkerIDs[talker]; !ok {
+		return nil, false
+	}
+
+	if target := TalkerID(raw[2:4]); raw[4:] == QueryCode {
+		if _, ok := TalkerIDs[target]; ok {
+			return QueryTypeID{TypeID: TypeID{Talker: talker, Code: QueryCode}, Target: target}, true
+		}
+	}
+
+	if _, ok := TypeIDs[string(TalkerIDGPS)+code]; !ok {
+		return nil, false
+	}
+
+	return TypeID{Talker: talker, Code: code}, true
+}
+
 func (m *Message) parse(data string) (err error) {
 	if len(data) < (len(Prefix) + len(Suffix) + 2) { // +2 for checksum in hex format
 		return fmt.Errorf("Wrong length")
@@ -88,7 +121,9 @@ func (m *Message) parse(data string) (err error) {
 
 	typ, ok := TypeIDs[fields[0]]
 	if !ok {
-		return fmt.Errorf("Message should contains a valid type id (got: %s)", fields[0])
+		if typ, ok = lookupTalkerTypeID(fields[0]); !ok {
+			return fmt.Errorf("Message should contains a valid type id (got: %s)", fields[0])
+		}
 	}
 	m.Type = typ
 
@@ -150,5 +185,192 @@ func Parse(raw string) (NMEA, error) {
 		return gptxt, err
 	}
 
+	switch m.Type.GetTypeID().Code {
+	case "HDT":
+		hdt := NewHDT(*m)
+		err = hdt.parse()
+		return hdt, err
+	case "HDG":
+		hdg := NewHDG(*m)
+		err = hdg.parse()
+		return hdg, err
+	case "HDM":
+		hdm := NewHDM(*m)
+		err = hdm.parse()
+		return hdm, err
+	case "THS":
+		ths := NewTHS(*m)
+		err = ths.parse()
+		return ths, err
+	case "MWV":
+		mwv := NewMWV(*m)
+		err = mwv.parse()
+		return mwv, err
+	case "MWD":
+		mwd := NewMWD(*m)
+		err = mwd.parse()
+		return mwd, err
+	case "VWR":
+		vwr := NewVWR(*m)
+		err = vwr.parse()
+		return vwr, err
+	case "DBT":
+		dbt := NewDBT(*m)
+		err = dbt.parse()
+		return dbt, err
+	case "DBS":
+		dbs := NewDBS(*m)
+		err = dbs.parse()
+		return dbs, err
+	case "DPT":
+		dpt := NewDPT(*m)
+		err = dpt.parse()
+		return dpt, err
+	case "MTW":
+		mtw := NewMTW(*m)
+		err = mtw.parse()
+		return mtw, err
+	case "MTA":
+		mta := NewMTA(*m)
+		err = mta.parse()
+		return mta, err
+	case "MDA":
+		mda := NewMDA(*m)
+		err = mda.parse()
+		return mda, err
+	case "XDR":
+		xdr := NewXDR(*m)
+		err = xdr.parse()
+		return xdr, err
+	case "VHW":
+		vhw := NewVHW(*m)
+		err = vhw.parse()
+		return vhw, err
+	case "VBW":
+		vbw := NewVBW(*m)
+		err = vbw.parse()
+		return vbw, err
+	case "VLW":
+		vlw := NewVLW(*m)
+		err = vlw.parse()
+		return vlw, err
+	case "RMB":
+		rmb := NewRMB(*m)
+		err = rmb.parse()
+		return rmb, err
+	case "APB":
+		apb := NewAPB(*m)
+		err = apb.parse()
+		return apb, err
+	case "XTE":
+		xte := NewXTE(*m)
+		err = xte.parse()
+		return xte, err
+	case "BOD":
+		bod := NewBOD(*m)
+		err = bod.parse()
+		return bod, err
+	case "BWC":
+		bwc := NewBWC(*m)
+		err = bwc.parse()
+		return bwc, err
+	case "BWR":
+		bwr := NewBWR(*m)
+		err = bwr.parse()
+		return bwr, err
+	case "WPL":
+		wpl := NewWPL(*m)
+		err = wpl.parse()
+		return wpl, err
+	case "RTE":
+		rte := NewRTE(*m)
+		err = rte.parse()
+		return rte, err
+	case "ROT":
+		rot := NewROT(*m)
+		err = rot.parse()
+		return rot, err
+	case "RSA":
+		rsa := NewRSA(*m)
+		err = rsa.parse()
+		return rsa, err
+	case "RPM":
+		rpm := NewRPM(*m)
+		err = rpm.parse()
+		return rpm, err
+	case "HSC":
+		hsc := NewHSC(*m)
+		err = hsc.parse()
+		return hsc, err
+	case "TTM":
+		ttm := NewTTM(*m)
+		err = ttm.parse()
+		return ttm, err
+	case "TLL":
+		tll := NewTLL(*m)
+		err = tll.parse()
+		return tll, err
+	case "OSD":
+		osd := NewOSD(*m)
+		err = osd.parse()
+		return osd, err
+	case "ALM":
+		alm := NewALM(*m)
+		err = alm.parse()
+		return alm, err
+	case "ALR":
+		alr := NewALR(*m)
+		err = alr.parse()
+		return alr, err
+	case "ALF":
+		alf := NewALF(*m)
+		err = alf.parse()
+		return alf, err
+	case "ACK":
+		ack := NewACK(*m)
+		err = ack.parse()
+		return ack, err
+	case "ACN":
+		acn := NewACN(*m)
+		err = acn.parse()
+		return acn, err
+	case "GSA":
+		gpgsa := NewGPGSA(*m)
+		err = gpgsa.parse()
+		return gpgsa, err
+	case "GSV":
+		gpgsv := NewGPGSV(*m)
+		err = gpgsv.parse()
+		return gpgsv, err
+	case "RMC":
+		gprmc := NewGPRMC(*m)
+		err = gprmc.parse()
+		return gprmc, err
+	case "VTG":
+		gpvtg := NewGPVTG(*m)
+		err = gpvtg.parse()
+		return gpvtg, err
+	case "GGA":
+		gpgga := NewGPGGA(*m)
+		err = gpgga.parse()
+		return gpgga, err
+	case "GNS":
+		gns := NewGNS(*m)
+		err = gns.parse()
+		return gns, err
+	case "GLL":
+		gpgll := NewGPGLL(*m)
+		err = gpgll.parse()
+		return gpgll, err
+	case "ZDA":
+		zda := NewZDA(*m)
+		err = zda.parse()
+		return zda, err
+	case QueryCode:
+		query := NewQuery(*m)
+		err = query.parse()
+		return query, err
+	}
+
 	return m, err
 }
@@ -16,6 +16,190 @@ func TestNMEAMessage(t *testing.T) {
 		"$GPGLL,3110.2908,N,12123.2348,E,041139.000,A,A*59",
 		"$GPTXT,01,01,02,ANTSTATUS=OK*3B",
 
+		// RTK, dead reckoning and NMEA 4.1 navigational status
+		"$GPGGA,015540.000,3150.68378,N,11711.93139,E,4,17,0.6,0051.6,M,0.0,M,,*5D",
+		"$GPGGA,015540.000,3150.68378,N,11711.93139,E,5,17,0.6,0051.6,M,0.0,M,,*5C",
+		"$GPRMC,013732.000,A,3150.7238,N,11711.7278,E,0.00,0.00,220413,,,F,C*00",
+		"$GNRMC,101520.00,A,4807.03812,N,01131.00031,E,0.012,45.30,220422,,,R,S*2D",
+		"$GNGGA,101520.00,4807.03812,N,01131.00031,E,4,12,0.62,519.4,M,47.6,M,1.2,0031*67",
+		"$GPGGA,092750.000,5321.6802,N,00630.3372,W,1,8,1.03,-12.5,M,55.2,M,,*5D",
+		"$GPGGA,123519,4807.038,N,01131.000,E,1,08,0.9,545.4,M,46.9,M,,*47",
+		"$GNGNS,014035.00,4332.69262,S,17235.48549,E,RR,13,0.9,25.63,11.24,,*70",
+		"$GNGNS,112257.00,3844.24011,N,00908.43828,W,AN,03,10.5,,,,,V*33",
+		"$GPGNS,,,,,,N,,,,,,*03",
+		"$GPVTG,0.00,T,,M,0.00,N,0.00,K,E*39",
+
+		// Time of day with various precisions or not available
+		"$GPGGA,015540.00,3150.68378,N,11711.93139,E,1,17,0.6,0051.6,M,0.0,M,,*68",
+		"$GPGGA,015540,3150.68378,N,11711.93139,E,1,17,0.6,0051.6,M,0.0,M,,*46",
+		"$GPGGA,,,,,,0,0,,,M,,M,,*56",
+		"$GPRMC,081836,A,3751.65,S,14507.36,E,000.0,360.0,130998,011.3,E,A*0F",
+		"$GPRMC,081836,A,3751.65,S,14507.36,E,000.0,360.0,130998,011.3,E*62",
+		"$GPRMC,225446,A,4916.45,N,12311.12,W,000.5,054.7,191194,020.3,E*68",
+		"$GPRMC,220516,A,5133.82,N,00042.24,W,173.8,231.8,130694,004.2,W*70",
+		"$GPGLL,4916.45,N,12311.12,W,225444,A*31",
+		"$GPGGA,123519,4807,N,01131,E,1,08,0.9,545.4,M,46.9,M,,*4C",
+		"$GPRMC,,V,,,,,0.00,0.00,,,,N*53",
+		"$GPGLL,4916.45,N,12311.12,W,225444,A,A*5C",
+
+		// Coordinates with various precisions, zero padding or exactly zero
+		"$GPGGA,123519.00,4807.038,N,01131.000,E,1,8,0.9,0545.4,M,46.9,M,,*69",
+		"$GPGLL,5133.82,N,00042.24,W,220516,A,A*59",
+		"$GPGLL,0000.0000,N,00000.0000,E,120000.00,A,A*6A",
+
+		// Time & Date
+		"$GPZDA,201530.00,04,07,2002,00,00*60",
+		"$GPZDA,160012.71,11,03,2004,-01,00*4D",
+		"$GPZDA,,,,,00,00*48",
+
+		// Heading sentences (from gyro, magnetic compass or integrated navigation talkers)
+		"$HEHDT,274.1,T*2F",
+		"$GPHDT,0.0,T*35",
+		"$HCHDG,98.3,0.0,E,12.6,W*57",
+		"$HCHDG,238.5,,,,*4E",
+		"$HCHDM,238.5,M*25",
+		"$INTHS,77.5,A*12",
+		"$HETHS,,V*14",
+		"$HEHDT,274.12,T*1D",
+		"$HCHDG,98.30,0.05,E,012.6,W*62",
+		"$HCHDM,238,M*3E",
+		"$INTHS,77.55,A*27",
+		"$HEHDT,,T*01",
+		"$HCHDM,,M*07",
+		"$HCHDG,,,,,*6C",
+		"$HCHDG,98.3,0.0,,,*5E",
+
+		// Wind sentences
+		"$WIMWV,214.8,R,0.1,K,A*28",
+		"$WIMWV,20.0,T,12.4,N,A*20",
+		"$WIMWV,,R,,N,V*34",
+		"$WIMWD,10.1,T,10.1,M,12.0,N,6.2,M*6D",
+		"$WIMWD,,T,,M,,N,,M*5A",
+		"$IIVWR,045.0,L,12.6,N,6.5,M,23.3,K*52",
+		"$WIMWV,214.85,R,0.12,K,A*2F",
+		"$WIMWD,270,T,265.5,M,12.05,N,6.2,M*47",
+		"$IIVWR,0.0,L,12.60,N,6.48,M,23.3,K*5A",
+		"$IIVWR,-0.0,L,0,N,0,M,0,K*64",
+
+		// Depth sentences
+		"$SDDBT,7.8,f,2.4,M,1.3,F*0D",
+		"$SDDBT,,f,,M,,F*28",
+		"$SDDBS,9.4,f,2.9,M,1.6,F*00",
+		"$SDDBT,12.34,f,3.76,M,2.06,F*04",
+		"$SDDBS,031.0,f,9.45,M,5.168,F*31",
+		"$SDDPT,2.4,0.5,10.0*67",
+		"$SDDPT,12.6,-1.2,100.0*4F",
+		"$SDDPT,3.60,0.0*62",
+		"$SDDPT,2.4,,10.0*4C",
+		"$SDDPT,,,*7B",
+		"$SDDPT,,*57",
+
+		// Environmental sentences
+		"$YXMTW,17.8,C*1C",
+		"$IIMTA,22.5,C*00",
+		"$IIMDA,30.12,I,1.020,B,22.5,C,17.8,C,64.2,,14.1,C,210.0,T,208.5,M,12.4,N,6.4,M*2F",
+		"$IIMDA,,I,,B,,C,17.8,C,,,,C,,T,,M,,N,,M*0A",
+		"$IIXDR,C,22.5,C,AIRTEMP,P,1.02481,B,BARO,H,64.2,P,HUMIDITY*30",
+		"$IIXDR,A,-2.5,D,PITCH,A,1.2,D,ROLL,U,12.6,V,BATT1*16",
+		"$IIXDR,G,1,,RELAY1*4A",
+		"$IIXDR,C,22.55,C,AIRTEMP,P,101325,P,BARO,H,64,P,HUMIDITY*2F",
+		"$IIMTW,17.25,C*22",
+
+		// Speed through water and log sentences
+		"$VWVHW,245.1,T,245.1,M,5.2,N,9.6,K*5C",
+		"$VWVHW,,T,,M,0.0,N,0.0,K*54",
+		"$VWVBW,5.2,-0.1,A,5.4,0.2,A*6A",
+		"$VWVBW,5.2,-0.1,A,,,V,0.3,A,,V*44",
+		"$VWVLW,1254.7,N,12.3,N*49",
+		"$VWVLW,1254.7,N,12.3,N,1302.1,N,12.9,N*42",
+		"$VWVBW,5.2,-0.1,A,,,V,,V,,V*7E",
+		"$VWVLW,1254.7,N,12.3,N,,N,,N*49",
+		"$VWVLW,,N,,N*4C",
+		"$VWVHW,245.15,T,245,M,5.20,N,9.6,K*46",
+
+		// Navigation to waypoint sentences
+		"$GPRMB,A,0.66,L,003,004,4917.24,N,12309.57,W,001.3,052.5,000.5,V*20",
+		"$GPRMB,A,4.08,L,EGLL,EGLM,5130.02,N,00046.34,W,004.6,213.9,122.9,A,A*50",
+		"$GPAPB,A,A,0.10,R,N,V,V,011.0,M,DEST,011.0,M,011.0,M*22",
+		"$GPXTE,A,A,0.67,L,N*6F",
+		"$GPXTE,A,A,0.67,L,N,A*02",
+		"$GPBOD,099.3,T,105.6,M,POINTB,POINTA*45",
+		"$GPBOD,097.0,T,103.2,M,POINTB,*47",
+		"$GPBWC,225444,4917.24,N,12309.57,W,051.9,T,031.6,M,001.3,N,004*29",
+		"$GPBWR,225444,4917.24,N,12309.57,W,051.9,T,031.6,M,001.3,N,004,A*55",
+		"$GPRMB,V,,,,,,,,,,,,V,N*04",
+		"$GPRMB,A,0.66,L,003,004,4917.2400,N,12309.5700,W,1.3,52.5,0.5,V*10",
+		"$GPAPB,V,V,,,N,V,V,,,,,,,,N*68",
+		"$GPXTE,V,V,,,N,N*5E",
+		"$GPBWC,081837,,,,,,T,,M,,N,,A*7E",
+		"$GPBWR,220516,5130.02,N,00046.34,W,213.8,T,218.0,M,0004.6,N,EGLM,A*5D",
+		"$GPBWC,225444.00,4917.24,N,12309.57,W,051.9,T,031.6,M,001.3,N,004*07",
+
+		// Waypoint and route sentences
+		"$GPWPL,4917.16,N,12310.64,W,003*65",
+		"$GPWPL,5130.02,N,00000.0,E,GREENW*72",
+		"$GPRTE,2,1,c,0,PBRCPK,PBRTO,PTELGR,PPLAND,PYAMBU,PPFAIR,PWARRN,PMORTL,PLISMR*73",
+		"$GPRTE,2,2,c,0,PCRCHS,PCHLMR*16",
+		"$GPRTE,1,1,w,,START*4F",
+
+		// Steering and engine sentences
+		"$HEROT,-2.3,A*07",
+		"$TIROT,35.6,A*0B",
+		"$TIROT,,V*02",
+		"$HEROT,-2.35,A*32",
+		"$IIRSA,10.5,A,,V*4D",
+		"$IIRSA,-4.2,A,-3.9,A*4C",
+		"$IIRSA,10.55,A,-03.0,A*5F",
+		"$ERRPM,E,1,2418.2,10.5,A*48",
+		"$ERRPM,S,2,-120.0,-35.0,A*61",
+		"$ERRPM,S,1,,,V*40",
+		"$ERRPM,E,2,2418,10,A*4C",
+		"$APHSC,040.0,T,041.5,M*54",
+		"$APHSC,,T,041.5,M*7E",
+
+		// Radar target sentences
+		"$RATTM,11,11.4,13.6,T,7.0,20.0,T,0.2,-0.8,N,TGT11,T,,100021.00,A*50",
+		"$RATTM,02,2.5,268.1,R,0.0,0.0,T,2.5,,N,,Q,R*18",
+		"$RATLL,01,4917.24,N,12309.57,W,TGT01,225444.00,T,*78",
+		"$RATTM,11,11.4,13.6,T,7.0,20.0,T,0.2,-0.8,N,TGT11,T,,,*3D",
+		"$RATTM,03,0.5,045.0,T,12.1,180.0,T,0.1,4.2,N,TGT03,T,,100021,A*54",
+		"$RATLL,02,5130.0200,N,00046.3400,W,,,L,*0C",
+		"$RATLL,01,4917.24,N,12309.57,W,TGT01,225444,T,R*04",
+		"$RAOSD,35.1,A,36.0,P,10.2,P,15.3,4.1,N*45",
+		"$RAOSD,,V,,B,,W,,,K*6F",
+		"$RAOSD,035.15,A,36.00,P,10.25,P,015.3,4.15,N*40",
+
+		// GPS almanac
+		"$GPALM,1,1,15,1159,00,441D,4E,16BE,FD5E,A10C9F,4A2DA4,686E81,58CBE1,0A4,001*77",
+		"$GPALM,31,1,01,2143,00,0D4B,90,12F1,FD46,A10D64,7C40D1,A1E95B,1B1E92,04A,000*33",
+
+		// Alert management sentences
+		"$IIALR,220516.00,001,A,V,BILGE ALARM*4F",
+		"$IIACK,001*54",
+		"$ECALF,1,1,0,124304.50,A,W,V,,192,1,1,0,LOST TARGET*05",
+		"$ECALF,2,2,0,,,,,,192,1,,,TARGET 12 OUT OF RANGE*50",
+		"$ECALF,1,1,1,124310.00,B,A,A,SAL,3015,,2,0,NO SPEED LOG*60",
+		"$ECALF,2,2,5,,,,,SAL,3016,,,,PRESS ACK TO SILENCE*60",
+		"$ECALF,1,1,2,124304.125,A,W,V,,193,1,1,0,CPA ALERT*3C",
+		"$ECACN,124305.5,,192,1,A,C*59",
+		"$IIALR,220516,002,A,V,FIRE ALARM*3F",
+		"$ECACN,124305.00,,192,1,A,C*6C",
+
+		// Query sentences
+		"$CCGPQ,GGA*2B",
+		"$ECGPQ,RMC*30",
+		"$IIHEQ,HDT*28",
+		// Multi-constellation satellites
+		"$GLGSV,2,1,07,65,38,045,32,66,73,308,35,72,24,145,28,74,12,031,*6B",
+		"$GLGSV,2,2,07,81,09,330,,82,50,262,30,88,19,103,25*55",
+		"$GNGSA,A,3,05,13,15,18,,,,,,,,,1.86,1.02,1.56*18",
+		"$GNGSA,A,3,66,65,74,,,,,,,,,,1.86,1.02,1.56*12",
+		"$GNGSA,A,3,80,71,73,79,69,,,,,,,,1.83,1.09,1.47,2*09",
+		"$GQGSA,A,3,02,03,,,,,,,,,,,1.86,1.02,1.56,5*15",
+		"$GAGSV,1,1,03,02,38,077,41,08,21,152,37,30,54,301,44,7*45",
+		"$GQGSV,1,1,02,02,60,180,45,03,35,200,40,1*68",
+		"$GPGSV,1,1,00,1*64",
+
 		// NMEA packet when no satellite received
 		"$GPGLL,,,,,000107.799,V,N*7B",
 		"$GPTXT,01,01,02,ANTSTATUS=OPEN*2B",
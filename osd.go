@@ -0,0 +1,124 @@
+package nmea
+
+import "fmt"
+
+// Examples:
+// $RAOSD,35.1,A,36.0,P,10.2,P,15.3,4.1,N*45
+// $RAOSD,,V,,B,,W,,,K*6F
+
+func NewOSD(m Message) *OSD {
+	return &OSD{Message: m}
+}
+
+// OSD is the own ship data, speeds are expressed according to SpeedUnit
+type OSD struct {
+	Message
+
+	Heading        *float64 // Heading (true) in degree, empty if not available
+	IsHeadingValid DataValid
+	Course         *float64 // Vessel course (true) in degree, empty if not available
+	CourseRef      MotionReference
+	Speed          *float64 // Vessel speed, empty if not available
+	SpeedRef       MotionReference
+	Set            *float64 // Vessel set (true) in degree, empty if not available
+	Drift          *float64 // Vessel drift (speed), empty if not available
+	SpeedUnit      SpeedUnit
+}
+
+func (m *OSD) parse() (err error) {
+	if len(m.Fields) != 9 {
+		return m.Error(fmt.Errorf("Incomplete OSD message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 9))
+	}
+
+	for i, v := range map[int]**float64{
+		0: &m.Heading,
+		2: &m.Course,
+		4: &m.Speed,
+		6: &m.Set,
+		7: &m.Drift,
+	} {
+		if *v, err = parseOptionalFloat(m.Fields[i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field at %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	m.IsHeadingValid = (m.Fields[1] == "A")
+
+	if m.CourseRef, err = ParseMotionReference(m.Fields[3]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse course reference from data field (got: %s)", m.Fields[3]))
+	}
+
+	if m.SpeedRef, err = ParseMotionReference(m.Fields[5]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse speed reference from data field (got: %s)", m.Fields[5]))
+	}
+
+	if m.SpeedUnit, err = ParseSpeedUnit(m.Fields[8]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse speed unit from data field (got: %s)", m.Fields[8]))
+	}
+
+	return nil
+}
+
+func (m OSD) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("OSD")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		formatOptionalFloat(m.Heading, m.field(0), "%.1f"),
+		m.IsHeadingValid.Serialize(),
+		formatOptionalFloat(m.Course, m.field(2), "%.1f"),
+		m.CourseRef.Serialize(),
+		formatOptionalFloat(m.Speed, m.field(4), "%.1f"),
+		m.SpeedRef.Serialize(),
+		formatOptionalFloat(m.Set, m.field(6), "%.1f"),
+		formatOptionalFloat(m.Drift, m.field(7), "%.1f"),
+		m.SpeedUnit.Serialize(),
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	MotionReferenceBottom      MotionReference = "B" // Bottom tracking log
+	MotionReferenceManual      MotionReference = "M" // Manually entered
+	MotionReferenceWater       MotionReference = "W" // Water referenced
+	MotionReferenceRadar       MotionReference = "R" // Radar tracking (of fixed target)
+	MotionReferencePositioning MotionReference = "P" // Positioning system ground reference
+)
+
+type MotionReference string
+
+func (r MotionReference) Serialize() string {
+	return string(r)
+}
+
+func (r MotionReference) String() string {
+	switch r {
+	case MotionReferenceBottom:
+		return "Bottom tracking log"
+	case MotionReferenceManual:
+		return "Manually entered"
+	case MotionReferenceWater:
+		return "Water referenced"
+	case MotionReferenceRadar:
+		return "Radar tracking"
+	case MotionReferencePositioning:
+		return "Positioning system ground reference"
+	default:
+		return "unknow"
+	}
+}
+
+func ParseMotionReference(raw string) (r MotionReference, err error) {
+	r = MotionReference(raw)
+	switch r {
+	case MotionReferenceBottom, MotionReferenceManual, MotionReferenceWater, MotionReferenceRadar, MotionReferencePositioning:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
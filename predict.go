@@ -0,0 +1,124 @@
+package nmea
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+const (
+	// GPS orbital constants (see IS-GPS-200)
+	// GPSEarthGravitationalConstant is the WGS84 value of the earth's gravitational constant (mu) in m^3/s^2
+	GPSEarthGravitationalConstant = 3.986005e14
+	// GPSEarthRotationRate is the WGS84 value of the earth's rotation rate in rad/s
+	GPSEarthRotationRate = 7.2921151467e-5
+	// GPSSecondsPerWeek is the duration of a GPS week in seconds
+	GPSSecondsPerWeek = 604800
+	// GPSWeekRollover is the number of weeks encoded by the legacy 10 bits GPS week number
+	GPSWeekRollover = 1024
+)
+
+var (
+	// GPSEpoch is the origin of GPS time
+	GPSEpoch = time.Date(1980, time.January, 6, 0, 0, 0, 0, time.UTC)
+	// GPSLeapSeconds is the offset in seconds between GPS time and UTC, valid since 2017-01-01 (update it when
+	// IERS announces a new leap second, ie: from the UTC parameters of the navigation message)
+	GPSLeapSeconds = 18
+)
+
+// GPSTime return GPS week number (without rollover) and time of week in seconds from UTC time
+func GPSTime(t time.Time) (week int, tow float64) {
+	elapsed := t.UTC().Sub(GPSEpoch).Seconds() + float64(GPSLeapSeconds)
+	week = int(math.Floor(elapsed / GPSSecondsPerWeek))
+	return week, elapsed - float64(week)*GPSSecondsPerWeek
+}
+
+// Position return the satellite position in ECEF (Earth-Centered, Earth-Fixed) coordinates in meter at UTC time
+func (e AlmanacEntry) Position(t time.Time) (x, y, z float64) {
+	week, tow := GPSTime(t)
+
+	// Almanac week may be truncated to 10 bits, use the nearest matching week
+	almanacWeek := e.Week
+	if almanacWeek < GPSWeekRollover {
+		almanacWeek += (week - almanacWeek + GPSWeekRollover/2) / GPSWeekRollover * GPSWeekRollover
+	}
+
+	tk := float64(week-almanacWeek)*GPSSecondsPerWeek + tow - e.Toa // Time from almanac reference epoch
+
+	a := e.SqrtA * e.SqrtA
+	n := math.Sqrt(GPSEarthGravitationalConstant / (a * a * a)) // Mean motion
+	mk := e.MeanAnomaly + n*tk
+
+	// Solve Kepler's equation for eccentric anomaly
+	ek := mk
+	for i := 0; i < 10; i++ {
+		ek = mk + e.Eccentricity*math.Sin(ek)
+	}
+
+	nu := math.Atan2(math.Sqrt(1-e.Eccentricity*e.Eccentricity)*math.Sin(ek), math.Cos(ek)-e.Eccentricity) // True anomaly
+	phi := nu + e.ArgumentOfPerigee                                                                        // Argument of latitude
+	r := a * (1 - e.Eccentricity*math.Cos(ek))
+
+	xp, yp := r*math.Cos(phi), r*math.Sin(phi) // Position in orbital plane
+
+	omega := e.LongitudeOfNode + (e.RateOfRightAscension-GPSEarthRotationRate)*tk - GPSEarthRotationRate*e.Toa
+
+	x = xp*math.Cos(omega) - yp*math.Cos(e.Inclination)*math.Sin(omega)
+	y = xp*math.Sin(omega) + yp*math.Cos(e.Inclination)*math.Cos(omega)
+	z = yp * math.Sin(e.Inclination)
+	return
+}
+
+// LookAngles return elevation and azimuth in degree of the satellite seen from observer at UTC time (altitude in meter above ellipsoid)
+func (e AlmanacEntry) LookAngles(t time.Time, p Position, altitude float64) (elevation, azimuth float64) {
+	var s ECEF
+	s.X, s.Y, s.Z = e.Position(t)
+	n := s.ENU(p, altitude)
+
+	elevation = math.Atan2(n.Up, math.Hypot(n.East, n.North)) * 180 / math.Pi
+	azimuth = normalizeDegrees(math.Atan2(n.East, n.North) * 180 / math.Pi)
+	return
+}
+
+// Predict return predicted elevation and azimuth of each satellite of the almanac by PRN (elevation is negative when below horizon)
+func (a Almanac) Predict(t time.Time, p Position, altitude float64) map[int]Satellite {
+	satellites := make(map[int]Satellite, len(a))
+	for prn, e := range a {
+		el, az := e.LookAngles(t, p, altitude)
+
+		elevation, azimuth := int(math.Round(el)), int(math.Round(az))%360
+		satellites[prn] = Satellite{ID: PrependToIntXZero(prn, 2), Elevation: &elevation, Azimuth: &azimuth}
+	}
+	return satellites
+}
+
+// Visible return predicted healthy satellites above elevation mask (in degree) ordered by PRN like GPGSV
+func (a Almanac) Visible(t time.Time, p Position, altitude float64, mask int) []Satellite {
+	prns := make([]int, 0, len(a))
+	for prn, e := range a {
+		if e.Health == 0 {
+			prns = append(prns, prn)
+		}
+	}
+	sort.Ints(prns)
+
+	predicted := a.Predict(t, p, altitude)
+
+	satellites := make([]Satellite, 0)
+	for _, prn := range prns {
+		if s := predicted[prn]; *s.Elevation >= mask {
+			satellites = append(satellites, s)
+		}
+	}
+	return satellites
+}
+
+// ecefToENU rotate an ECEF vector into local East, North, Up frame at given position
+func ecefToENU(dx, dy, dz float64, p Position) (east, north, up float64) {
+	lat, lon := p.radians()
+
+	east = -math.Sin(lon)*dx + math.Cos(lon)*dy
+	north = -math.Sin(lat)*math.Cos(lon)*dx - math.Sin(lat)*math.Sin(lon)*dy + math.Cos(lat)*dz
+	up = math.Cos(lat)*math.Cos(lon)*dx + math.Cos(lat)*math.Sin(lon)*dy + math.Sin(lat)*dz
+	return
+}
@@ -0,0 +1,88 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestAlmanacPredict(t *testing.T) {
+	msg, err := Parse("$GPALM,31,1,01,2143,00,0D4B,90,12F1,FD46,A10D64,7C40D1,A1E95B,1B1E92,04A,000*33")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := msg.(*ALM).AlmanacEntry
+	almanac := Almanac{entry.PRN: entry}
+
+	// Reference epoch of the almanac expressed in UTC
+	toa := GPSEpoch.Add(time.Duration(entry.Week*GPSSecondsPerWeek+int(entry.Toa)-GPSLeapSeconds) * time.Second)
+	if week, tow := GPSTime(toa); week != entry.Week || tow != entry.Toa {
+		t.Fatalf("Wrong GPS time conversion (got: week %d, tow %f)", week, tow)
+	}
+
+	for _, offset := range []time.Duration{0, 3 * time.Hour, 2 * 24 * time.Hour} {
+		at := toa.Add(offset)
+
+		x, y, z := entry.Position(at)
+		r, a := math.Sqrt(x*x+y*y+z*z), entry.SqrtA*entry.SqrtA
+		if r < a*(1-entry.Eccentricity)-1 || r > a*(1+entry.Eccentricity)+1 {
+			t.Fatalf("Satellite out of orbit (got: radius %f m, semi-major axis %f m)", r, a)
+		}
+
+		// Observer right under the satellite should see it at zenith
+		lat, lon := math.Atan2(z, math.Hypot(x, y)), math.Atan2(y, x)
+		if sat := almanac.Predict(at, positionFromRadians(lat, lon), 0)[entry.PRN]; *sat.Elevation < 89 {
+			t.Fatalf("Satellite should be at zenith (got: elevation %d)", *sat.Elevation)
+		}
+
+		// Observer on the other side of the earth shouldn't see it
+		if visible := almanac.Visible(at, positionFromRadians(-lat, lon+math.Pi), 0, 0); len(visible) != 0 {
+			t.Fatalf("Satellite shouldn't be visible from antipode (got: %+v)", visible)
+		}
+	}
+
+	// Satellite north of the observer should be seen with azimuth close to 0°
+	x, y, z := entry.Position(toa)
+	observer := positionFromRadians(math.Atan2(z, math.Hypot(x, y)), math.Atan2(y, x))
+	observer.Latitude -= 20
+	if sat := almanac.Predict(toa, observer, 0)[entry.PRN]; *sat.Azimuth > 1 && *sat.Azimuth < 359 {
+		t.Fatalf("Satellite should be seen northward (got: azimuth %d)", *sat.Azimuth)
+	}
+
+	// GPS to UTC offset follows leap seconds announced after 2017
+	defer func(leapSeconds int) { GPSLeapSeconds = leapSeconds }(GPSLeapSeconds)
+	GPSLeapSeconds++
+	if week, tow := GPSTime(toa); week != entry.Week || tow != entry.Toa+1 {
+		t.Fatalf("Wrong GPS time conversion with a new leap second (got: week %d, tow %f)", week, tow)
+	}
+}
+
+func TestAlmanacOrbitPeriod(t *testing.T) {
+	msg, err := Parse("$GPALM,31,1,01,2143,00,0D4B,90,12F1,FD46,A10D64,7C40D1,A1E95B,1B1E92,04A,000*33")
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry := msg.(*ALM).AlmanacEntry
+
+	// GPS satellites orbit twice per sidereal day (nominal period of 11h 58min, see IS-GPS-200)
+	const siderealDay = 86164.0905
+	a := entry.SqrtA * entry.SqrtA
+	if period := 2 * math.Pi * math.Sqrt(a*a*a/GPSEarthGravitationalConstant); math.Abs(period-siderealDay/2) > 60 {
+		t.Fatalf("Wrong orbital period (got: %f s, expected: %f s)", period, siderealDay/2)
+	}
+
+	// So ground track repeats each sidereal day (shifted by a few kilometers by precession of the node)
+	toa := GPSEpoch.Add(time.Duration(entry.Week*GPSSecondsPerWeek+int(entry.Toa)-GPSLeapSeconds) * time.Second)
+	x1, y1, z1 := entry.Position(toa)
+	x2, y2, z2 := entry.Position(toa.Add(time.Duration(siderealDay * float64(time.Second))))
+	if d := math.Sqrt((x2-x1)*(x2-x1) + (y2-y1)*(y2-y1) + (z2-z1)*(z2-z1)); d > 100e3 {
+		t.Fatalf("Ground track should repeat after a sidereal day (got: %f km apart)", d/1000)
+	}
+
+	// While earth has only made half a turn after one orbit
+	x2, y2, z2 = entry.Position(toa.Add(time.Duration(siderealDay / 2 * float64(time.Second))))
+	if d := math.Sqrt((x2+x1)*(x2+x1) + (y2+y1)*(y2+y1) + (z2-z1)*(z2-z1)); d > 100e3 {
+		t.Fatalf("Satellite should be above the opposite meridian after one orbit (got: %f km apart)", d/1000)
+	}
+}
@@ -0,0 +1,89 @@
+package nmea
+
+import (
+	"fmt"
+	"strings"
+)
+
+const (
+	// QueryCode is the sentence code of query sentences
+	QueryCode = "Q"
+)
+
+// Examples:
+// $CCGPQ,GGA*2B
+// $IIHEQ,HDT*28
+
+func NewQuery(m Message) *Query {
+	return &Query{Message: m}
+}
+
+// Query is a request to a talker to output a specific sentence
+type Query struct {
+	Message
+
+	Requester TalkerID // Talker sending the query
+	Target    TalkerID // Talker asked to output the sentence
+	Sentence  string   // Requested sentence code (ie: "GGA")
+}
+
+func (m *Query) parse() (err error) {
+	hdr, ok := m.Type.(QueryTypeID)
+	if !ok {
+		return m.Error(fmt.Errorf("Invalid query header (got: %s)", m.Type.Serialize()))
+	}
+
+	if len(m.Fields) != 1 {
+		return m.Error(fmt.Errorf("Incomplete query message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 1))
+	}
+
+	m.Requester, m.Target, m.Sentence = hdr.Talker, hdr.Target, m.Fields[0]
+
+	if _, ok := TypeIDs[string(TalkerIDGPS)+m.Sentence]; !ok || m.Sentence == QueryCode {
+		return m.Error(fmt.Errorf("Unknown requested sentence (got: %s)", m.Sentence))
+	}
+
+	return nil
+}
+
+func (m Query) Serialize() string { // Implement NMEA interface
+
+	hdr := QueryTypeID{TypeID: TypeID{Talker: m.Requester, Code: QueryCode}, Target: m.Target}
+	fields := []string{m.Sentence}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// FixTracker keep the last received message of each sentence to answer queries
+type FixTracker struct {
+	last map[string]NMEA // Last message by header (ie: "GPGGA")
+}
+
+func NewFixTracker() *FixTracker {
+	return &FixTracker{last: make(map[string]NMEA)}
+}
+
+// Update record message as the last one for its header (queries are ignored)
+func (t *FixTracker) Update(msg NMEA) {
+	hdr := msg.GetMessage().Type
+	if hdr.GetTypeID().Code == QueryCode {
+		return
+	}
+	t.last[hdr.Serialize()] = msg
+}
+
+// Last return the last message received for a header (ie: "GPGGA"), nil if none
+func (t *FixTracker) Last(header string) NMEA {
+	return t.last[strings.ToUpper(header)]
+}
+
+// Answer return the last message matching target talker and requested sentence of the query
+func (t *FixTracker) Answer(q Query) (NMEA, error) {
+	if msg := t.Last(q.Target.Serialize() + q.Sentence); msg != nil {
+		return msg, nil
+	}
+	return nil, fmt.Errorf("No %s sentence available from %s talker", q.Sentence, q.Target)
+}
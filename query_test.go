@@ -0,0 +1,70 @@
+package nmea
+
+import "testing"
+
+func TestQuery(t *testing.T) {
+	raw := "$CCGPQ,GGA*2B"
+	msg, err := Parse(raw)
+	if err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+	}
+
+	q, ok := msg.(*Query)
+	if !ok {
+		t.Fatalf("Wrong message type (got: %T)", msg)
+	}
+	if q.Requester != TalkerIDCC || q.Target != TalkerIDGPS || q.Sentence != "GGA" {
+		t.Fatalf("Wrong query fields (got: %+v)", q)
+	}
+
+	built := Query{Requester: TalkerIDCC, Target: TalkerIDGPS, Sentence: "GGA"}
+	if out := built.Serialize(); out != raw {
+		t.Fatalf("Wrong serialization (got: %s, wanted: %s)", out, raw)
+	}
+
+	for _, invalid := range []string{
+		"$CCGPQ,XYZ*31",
+		"$CCGPQ,GGA,RMC*5B",
+	} {
+		if _, err := Parse(invalid); err == nil {
+			t.Fatalf("Query \"%s\" should be rejected", invalid)
+		}
+	}
+}
+
+func TestFixTrackerAnswer(t *testing.T) {
+	tracker := NewFixTracker()
+
+	for _, raw := range []string{
+		"$GPGGA,015540.000,3150.68378,N,11711.93139,E,1,17,0.6,0051.6,M,0.0,M,,*58",
+		"$HEHDT,274.1,T*2F",
+	} {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		tracker.Update(msg)
+	}
+
+	for raw, expected := range map[string]string{
+		"$CCGPQ,GGA*2B": "$GPGGA,015540.000,3150.68378,N,11711.93139,E,1,17,0.6,0051.6,M,0.0,M,,*58",
+		"$IIHEQ,HDT*28": "$HEHDT,274.1,T*2F",
+	} {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+
+		answer, err := tracker.Answer(*msg.(*Query))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out := answer.Serialize(); out != expected {
+			t.Fatalf("Wrong answer (got: %s, wanted: %s)", out, expected)
+		}
+	}
+
+	if _, err := tracker.Answer(Query{Requester: TalkerIDCC, Target: TalkerIDGPS, Sentence: "RMC"}); err == nil {
+		t.Fatal("Query without available sentence should fail")
+	}
+}
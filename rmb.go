@@ -0,0 +1,105 @@
+package nmea
+
+import "fmt"
+
+// Examples:
+// $GPRMB,A,0.66,L,003,004,4917.24,N,12309.57,W,001.3,052.5,000.5,V*20
+// $GPRMB,A,4.08,L,EGLL,EGLM,5130.02,N,00046.34,W,004.6,213.9,122.9,A,A*50
+
+func NewRMB(m Message) *RMB {
+	return &RMB{Message: m}
+}
+
+type RMB struct {
+	Message
+
+	IsValid              DataValid
+	CrossTrackError      *float64 // Magnitude of cross-track error in nautical miles, empty if not available
+	SteerDirection       SteerDirection
+	OriginWaypoint       string
+	DestinationWaypoint  string
+	DestinationLatitude  LatLong  // In decimal format
+	DestinationLongitude LatLong  // In decimal format
+	LatitudeDecimals     *int     // Number of decimals of minutes in latitude data field (NoCoordinate if empty), DefaultCoordinateDecimals if not specified (nil)
+	LongitudeDecimals    *int     // Number of decimals of minutes in longitude data field (NoCoordinate if empty), DefaultCoordinateDecimals if not specified (nil)
+	Range                *float64 // Range to destination in nautical miles, empty if not available
+	Bearing              *float64 // Bearing (true) to destination in degree, empty if not available
+	ClosingVelocity      *float64 // Destination closing velocity in knots, empty if not available
+	ArrivalStatus        ArrivalStatus
+	PositioningMode      PositioningMode // NMEA 2.3 and later, empty if not available
+}
+
+func (m *RMB) parse() (err error) {
+	if len(m.Fields) != 13 && len(m.Fields) != 14 {
+		return m.Error(fmt.Errorf("Incomplete RMB message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 13, 14))
+	}
+
+	m.IsValid = (m.Fields[0] == "A")
+
+	if m.CrossTrackError, m.SteerDirection, err = parseCrossTrackError(m.Fields[1], m.Fields[2]); err != nil {
+		return m.Error(err)
+	}
+
+	m.OriginWaypoint, m.DestinationWaypoint = m.Fields[3], m.Fields[4]
+
+	if m.DestinationLatitude, m.LatitudeDecimals, err = parseDMField(m.Fields[5], m.Fields[6]); err != nil {
+		return m.Error(err)
+	}
+
+	if m.DestinationLongitude, m.LongitudeDecimals, err = parseDMField(m.Fields[7], m.Fields[8]); err != nil {
+		return m.Error(err)
+	}
+
+	if m.Range, err = parseOptionalFloat(m.Fields[9]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse range to destination from data field (got: %s)", m.Fields[9]))
+	}
+
+	if m.Bearing, err = parseOptionalFloat(m.Fields[10]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse bearing to destination from data field (got: %s)", m.Fields[10]))
+	}
+
+	if m.ClosingVelocity, err = parseOptionalFloat(m.Fields[11]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse destination closing velocity from data field (got: %s)", m.Fields[11]))
+	}
+
+	m.ArrivalStatus = (m.Fields[12] == "A")
+
+	if len(m.Fields) == 14 {
+		if m.PositioningMode, err = ParsePositioningMode(m.Fields[13]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse GPS positioning mode from data field (got: %s)", m.Fields[13]))
+		}
+	}
+
+	return nil
+}
+
+func (m RMB) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("RMB")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		m.IsValid.Serialize(),
+		formatOptionalFloat(m.CrossTrackError, m.field(1), "%.2f"),
+		m.SteerDirection.Serialize(),
+		m.OriginWaypoint,
+		m.DestinationWaypoint,
+	)
+	fields = append(fields, serializeDMField(m.DestinationLatitude, true, m.LatitudeDecimals)...)
+	fields = append(fields, serializeDMField(m.DestinationLongitude, false, m.LongitudeDecimals)...)
+	fields = append(fields,
+		formatOptionalFloat(m.Range, m.field(9), "%05.1f"),
+		formatOptionalFloat(m.Bearing, m.field(10), "%05.1f"),
+		formatOptionalFloat(m.ClosingVelocity, m.field(11), "%05.1f"),
+		m.ArrivalStatus.Serialize(),
+	)
+
+	if len(m.PositioningMode) > 0 {
+		fields = append(fields, m.PositioningMode.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,52 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRMB(t *testing.T) {
+	raw := "$GPRMB,A,4.08,L,EGLL,EGLM,5130.02,N,00046.34,W,004.6,213.9,122.9,A,A*50"
+
+	msg, err := Parse(raw)
+	if err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+	}
+
+	rmb, ok := msg.(*RMB)
+	if !ok {
+		t.Fatalf("Wrong message type (got: %T)", msg)
+	}
+
+	if rmb.SteerDirection != SteerLeft || rmb.CrossTrackError == nil || *rmb.CrossTrackError != 4.08 {
+		t.Fatalf("Wrong cross-track error (got: %v %s)", rmb.CrossTrackError, rmb.SteerDirection)
+	}
+
+	if rmb.DestinationWaypoint != "EGLM" || rmb.ArrivalStatus != Arrived {
+		t.Fatalf("Wrong destination waypoint status (got: %s, %v)", rmb.DestinationWaypoint, rmb.ArrivalStatus)
+	}
+
+	if math.Abs(float64(rmb.DestinationLatitude)-(51+30.02/60)) > 1e-9 || math.Abs(float64(rmb.DestinationLongitude)+(46.34/60)) > 1e-9 {
+		t.Fatalf("Wrong destination position (got: %f, %f)", rmb.DestinationLatitude, rmb.DestinationLongitude)
+	}
+}
+
+func TestSerializeDM(t *testing.T) {
+	samples := []struct {
+		Value      LatLong
+		IsLatitude bool
+		Decimals   int
+		Expected   string
+	}{
+		{LatLong(51 + 30.02/60), true, 2, "5130.02"},
+		{LatLong(-(46.34 / 60)), false, 2, "00046.34"},
+		{LatLong(8 + 5.5/60), true, 4, "0805.5000"},
+		{LatLong(12 + 59.9999/60), false, 2, "01300.00"},
+	}
+
+	for _, s := range samples {
+		if got := s.Value.SerializeDM(s.IsLatitude, s.Decimals); got != s.Expected {
+			t.Fatalf("Wrong serialization of %f (got: %s, expected: %s)", s.Value, got, s.Expected)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package nmea
+
+import "fmt"
+
+// Examples:
+// $HEROT,-2.3,A*07
+// $TIROT,35.6,A*0B
+
+func NewROT(m Message) *ROT {
+	return &ROT{Message: m}
+}
+
+type ROT struct {
+	Message
+
+	RateOfTurn *float64 // Rate of turn in degree per minute, negative means bow turns to port, empty if not available
+	IsValid    DataValid
+}
+
+func (m *ROT) parse() (err error) {
+	if len(m.Fields) != 2 {
+		return m.Error(fmt.Errorf("Incomplete ROT message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 2))
+	}
+
+	if m.RateOfTurn, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse rate of turn from data field (got: %s)", m.Fields[0]))
+	}
+
+	m.IsValid = (m.Fields[1] == "A")
+
+	return nil
+}
+
+func (m ROT) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("ROT")
+	fields := make([]string, 0)
+
+	fields = append(fields, formatOptionalFloat(m.RateOfTurn, m.field(0), "%.1f"), m.IsValid.Serialize())
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
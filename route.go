@@ -0,0 +1,152 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+const (
+	// MaxSentenceLength is the maximum number of chars of a NMEA message (from $ to checksum, without CRLF)
+	MaxSentenceLength = 80
+)
+
+// Waypoint is a named position of a route
+type Waypoint struct {
+	Name      string
+	Latitude  LatLong // In decimal format
+	Longitude LatLong // In decimal format
+	Located   bool    // False if position of the waypoint was not received (see WPL)
+}
+
+// Route is an ordered list of waypoints
+type Route struct {
+	ID        string
+	Mode      RouteMode
+	Waypoints []Waypoint
+}
+
+// Names return waypoint identifiers in route order
+func (r Route) Names() []string {
+	names := make([]string, 0, len(r.Waypoints))
+	for _, w := range r.Waypoints {
+		names = append(names, w.Name)
+	}
+	return names
+}
+
+// WPL return waypoint location messages for each located waypoint of the route
+func (r Route) WPL() []WPL {
+	wpls := make([]WPL, 0, len(r.Waypoints))
+	for _, w := range r.Waypoints {
+		if w.Located {
+			wpls = append(wpls, WPL{Latitude: w.Latitude, Longitude: w.Longitude, Name: w.Name})
+		}
+	}
+	return wpls
+}
+
+// RTE split the route into numbered RTE messages which respect the NMEA length limit
+func (r Route) RTE() ([]RTE, error) {
+	mode := r.Mode
+	if len(mode) == 0 {
+		mode = RouteModeComplete
+	}
+
+	// Width of numbering fields depends on the number of messages, retry while it grows
+	for width := 1; ; width++ {
+		rtes, err := r.splitRTE(mode, width)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(strconv.Itoa(len(rtes))) <= width {
+			for i := range rtes {
+				rtes[i].NbOfMessage, rtes[i].SequenceNumber = len(rtes), i+1
+			}
+			return rtes, nil
+		}
+	}
+}
+
+func (r Route) splitRTE(mode RouteMode, width int) ([]RTE, error) {
+	// $GPRTE,n,n,c,id,...*hh where n are numbering fields of the expected width
+	base := len(Prefix+TypeIDs["GPRTE"].Serialize()) + 2*(len(FieldDelimiter)+width) + len(FieldDelimiter+mode.Serialize()) + len(FieldDelimiter+r.ID) + len(Suffix) + 2
+
+	rtes := make([]RTE, 0)
+	current := RTE{Mode: mode, RouteID: r.ID, Waypoints: make([]string, 0)}
+	length := base
+
+	for _, name := range r.Names() {
+		if base+len(FieldDelimiter+name) > MaxSentenceLength {
+			return nil, fmt.Errorf("Waypoint identifier too long to fit in a RTE message (got: %s)", name)
+		}
+
+		if length+len(FieldDelimiter+name) > MaxSentenceLength {
+			rtes = append(rtes, current)
+			current = RTE{Mode: mode, RouteID: r.ID, Waypoints: make([]string, 0)}
+			length = base
+		}
+
+		current.Waypoints = append(current.Waypoints, name)
+		length += len(FieldDelimiter + name)
+	}
+
+	return append(rtes, current), nil
+}
+
+// RouteAssembler rebuild complete routes from RTE messages and waypoint locations from WPL messages
+type RouteAssembler struct {
+	waypoints map[string]WPL
+	parts     map[string][]*RTE // Received RTE messages by route identifier (index is sequence number - 1)
+}
+
+func NewRouteAssembler() *RouteAssembler {
+	return &RouteAssembler{
+		waypoints: make(map[string]WPL),
+		parts:     make(map[string][]*RTE),
+	}
+}
+
+// Add handle a WPL or RTE message (others are ignored) and return the route when its last RTE message is received
+func (a *RouteAssembler) Add(msg NMEA) (*Route, error) {
+	switch m := msg.(type) {
+	case *WPL:
+		a.waypoints[m.Name] = *m
+	case *RTE:
+		return a.addRTE(*m)
+	}
+	return nil, nil
+}
+
+func (a *RouteAssembler) addRTE(m RTE) (*Route, error) {
+	parts, exists := a.parts[m.RouteID]
+	if !exists || m.SequenceNumber == 1 || len(parts) != m.NbOfMessage {
+		parts = make([]*RTE, m.NbOfMessage) // New transmission of the route
+	}
+
+	if parts[m.SequenceNumber-1] != nil {
+		delete(a.parts, m.RouteID)
+		return nil, fmt.Errorf("Duplicated sequence number for route %s (got: %d)", m.RouteID, m.SequenceNumber)
+	}
+
+	parts[m.SequenceNumber-1] = &m
+	a.parts[m.RouteID] = parts
+
+	route := Route{ID: m.RouteID, Mode: m.Mode, Waypoints: make([]Waypoint, 0)}
+	for _, p := range parts {
+		if p == nil {
+			return nil, nil // Wait for missing messages
+		}
+
+		for _, name := range p.Waypoints {
+			wp := Waypoint{Name: name}
+			if wpl, ok := a.waypoints[name]; ok {
+				wp.Latitude, wp.Longitude, wp.Located = wpl.Latitude, wpl.Longitude, true
+			}
+			route.Waypoints = append(route.Waypoints, wp)
+		}
+	}
+
+	delete(a.parts, m.RouteID)
+	return &route, nil
+}
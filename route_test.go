@@ -0,0 +1,94 @@
+package nmea
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestRouteAssembler(t *testing.T) {
+	nmeas := []string{
+		"$GPWPL,4917.16,N,12310.64,W,003*65",
+		"$GPWPL,4916.45,N,12311.12,W,PBRTO*0A",
+		"$GPRTE,2,1,c,0,PBRCPK,PBRTO,PTELGR,PPLAND,PYAMBU,PPFAIR,PWARRN,PMORTL,PLISMR*73",
+		"$GPRTE,2,2,c,0,PCRCHS,PCHLMR*16",
+	}
+
+	assembler := NewRouteAssembler()
+
+	var route *Route
+	for i, raw := range nmeas {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+
+		if route, err = assembler.Add(msg); err != nil {
+			t.Fatal(err)
+		}
+
+		if i < len(nmeas)-1 && route != nil {
+			t.Fatalf("Route shouldn't be complete before last RTE message (got: %s)", raw)
+		}
+	}
+
+	if route == nil {
+		t.Fatal("Route should be complete")
+	}
+
+	expected := []string{"PBRCPK", "PBRTO", "PTELGR", "PPLAND", "PYAMBU", "PPFAIR", "PWARRN", "PMORTL", "PLISMR", "PCRCHS", "PCHLMR"}
+	if !reflect.DeepEqual(route.Names(), expected) {
+		t.Fatalf("Wrong waypoints order (got: %v, expected: %v)", route.Names(), expected)
+	}
+
+	if wp := route.Waypoints[1]; !wp.Located || wp.Latitude.SerializeDM(true, 2) != "4916.45" {
+		t.Fatalf("Waypoint should be resolved from WPL message (got: %+v)", wp)
+	}
+
+	if route.Waypoints[0].Located {
+		t.Fatal("Waypoint without WPL message shouldn't be located")
+	}
+}
+
+func TestRouteRTE(t *testing.T) {
+	route := Route{ID: "ROUTE1", Mode: RouteModeComplete}
+	for i := 0; i < 120; i++ {
+		route.Waypoints = append(route.Waypoints, Waypoint{Name: fmt.Sprintf("WPT%03d", i)})
+	}
+
+	rtes, err := route.RTE()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assembler := NewRouteAssembler()
+
+	var rebuilt *Route
+	for i, rte := range rtes {
+		raw := rte.Serialize()
+		if len(raw) > MaxSentenceLength {
+			t.Fatalf("Message too long (got: %d chars for \"%s\")", len(raw), raw)
+		}
+
+		if rte.SequenceNumber != i+1 || rte.NbOfMessage != len(rtes) {
+			t.Fatalf("Wrong numbering (got: %d/%d)", rte.SequenceNumber, rte.NbOfMessage)
+		}
+
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+
+		if rebuilt, err = assembler.Add(msg); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if rebuilt == nil || !reflect.DeepEqual(rebuilt.Names(), route.Names()) {
+		t.Fatalf("Route should be rebuilt from RTE messages (got: %v)", rebuilt)
+	}
+
+	if _, err := (Route{Waypoints: []Waypoint{{Name: string(make([]byte, 80))}}}).RTE(); err == nil {
+		t.Fatal("Waypoint identifier too long should be rejected")
+	}
+}
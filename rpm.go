@@ -0,0 +1,102 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Examples:
+// $ERRPM,E,1,2418.2,10.5,A*48
+// $ERRPM,S,2,-120.0,-35.0,A*61
+
+func NewRPM(m Message) *RPM {
+	return &RPM{Message: m}
+}
+
+// RPM is the engine or shaft revolutions and propeller pitch
+type RPM struct {
+	Message
+
+	Source  RevolutionSource
+	Number  int      // Engine or shaft number, numbered from centerline (odd = starboard, even = port, 0 = single or on centerline)
+	Speed   *float64 // Speed in revolutions per minute, negative means counter-clockwise, empty if not available
+	Pitch   *float64 // Propeller pitch in percent of maximum, negative means astern, empty if not available
+	IsValid DataValid
+}
+
+func (m *RPM) parse() (err error) {
+	if len(m.Fields) != 5 {
+		return m.Error(fmt.Errorf("Incomplete RPM message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 5))
+	}
+
+	if m.Source, err = ParseRevolutionSource(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse revolution source from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.Number, err = strconv.Atoi(m.Fields[1]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse engine or shaft number from data field (got: %s)", m.Fields[1]))
+	}
+
+	if m.Speed, err = parseOptionalFloat(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse speed from data field (got: %s)", m.Fields[2]))
+	}
+
+	if m.Pitch, err = parseOptionalFloat(m.Fields[3]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse propeller pitch from data field (got: %s)", m.Fields[3]))
+	}
+
+	m.IsValid = (m.Fields[4] == "A")
+
+	return nil
+}
+
+func (m RPM) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("RPM")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		m.Source.Serialize(),
+		strconv.Itoa(m.Number),
+		formatOptionalFloat(m.Speed, m.field(2), "%.1f"),
+		formatOptionalFloat(m.Pitch, m.field(3), "%.1f"),
+		m.IsValid.Serialize(),
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	RevolutionSourceShaft  RevolutionSource = "S"
+	RevolutionSourceEngine RevolutionSource = "E"
+)
+
+type RevolutionSource string
+
+func (r RevolutionSource) Serialize() string {
+	return string(r)
+}
+
+func (r RevolutionSource) String() string {
+	switch r {
+	case RevolutionSourceShaft:
+		return "Shaft"
+	case RevolutionSourceEngine:
+		return "Engine"
+	default:
+		return "unknow"
+	}
+}
+
+func ParseRevolutionSource(raw string) (r RevolutionSource, err error) {
+	r = RevolutionSource(raw)
+	switch r {
+	case RevolutionSourceShaft, RevolutionSourceEngine:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
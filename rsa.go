@@ -0,0 +1,59 @@
+package nmea
+
+import "fmt"
+
+// Examples:
+// $IIRSA,10.5,A,,V*4D
+// $IIRSA,-4.2,A,-3.9,A*4C
+
+func NewRSA(m Message) *RSA {
+	return &RSA{Message: m}
+}
+
+// RSA is the rudder sensor angle, negative angle means bow turns to port
+type RSA struct {
+	Message
+
+	StarboardRudder        *float64 // Starboard (or single) rudder angle in degree, empty if not available
+	IsStarboardRudderValid DataValid
+	PortRudder             *float64 // Port rudder angle in degree, empty if not available
+	IsPortRudderValid      DataValid
+}
+
+func (m *RSA) parse() (err error) {
+	if len(m.Fields) != 4 {
+		return m.Error(fmt.Errorf("Incomplete RSA message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 4))
+	}
+
+	if m.StarboardRudder, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse starboard rudder angle from data field (got: %s)", m.Fields[0]))
+	}
+
+	m.IsStarboardRudderValid = (m.Fields[1] == "A")
+
+	if m.PortRudder, err = parseOptionalFloat(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse port rudder angle from data field (got: %s)", m.Fields[2]))
+	}
+
+	m.IsPortRudderValid = (m.Fields[3] == "A")
+
+	return nil
+}
+
+func (m RSA) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("RSA")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		formatOptionalFloat(m.StarboardRudder, m.field(0), "%.1f"),
+		m.IsStarboardRudderValid.Serialize(),
+		formatOptionalFloat(m.PortRudder, m.field(2), "%.1f"),
+		m.IsPortRudderValid.Serialize(),
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
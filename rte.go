@@ -0,0 +1,103 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Examples:
+// $GPRTE,2,1,c,0,PBRCPK,PBRTO,PTELGR,PPLAND,PYAMBU,PPFAIR,PWARRN,PMORTL,PLISMR*73
+// $GPRTE,2,2,c,0,PCRCHS,PCHLMR*16
+
+func NewRTE(m Message) *RTE {
+	return &RTE{Message: m}
+}
+
+// RTE is a part of a route (waypoint identifiers in order), see RouteAssembler to rebuild the complete route
+type RTE struct {
+	Message
+
+	NbOfMessage    int // Total number of RTE messages being transmitted for this route
+	SequenceNumber int // Sequence number of this entry (1 ~ NbOfMessage)
+	Mode           RouteMode
+	RouteID        string
+	Waypoints      []string // Waypoint identifiers
+}
+
+func (m *RTE) parse() (err error) {
+	if len(m.Fields) < 4 {
+		return m.Error(fmt.Errorf("Incomplete RTE message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 4))
+	}
+
+	if m.NbOfMessage, err = strconv.Atoi(m.Fields[0]); err != nil {
+		return m.Error(err)
+	}
+
+	if m.SequenceNumber, err = strconv.Atoi(m.Fields[1]); err != nil {
+		return m.Error(err)
+	}
+
+	if m.SequenceNumber < 1 || m.SequenceNumber > m.NbOfMessage {
+		return m.Error(fmt.Errorf("Sequence number out of range (got: %d)", m.SequenceNumber))
+	}
+
+	if m.Mode, err = ParseRouteMode(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse route mode from data field (got: %s)", m.Fields[2]))
+	}
+
+	m.RouteID = m.Fields[3]
+	m.Waypoints = append([]string{}, m.Fields[4:]...)
+
+	return nil
+}
+
+func (m RTE) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("RTE")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		strconv.Itoa(m.NbOfMessage),
+		strconv.Itoa(m.SequenceNumber),
+		m.Mode.Serialize(),
+		m.RouteID,
+	)
+	fields = append(fields, m.Waypoints...)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	RouteModeComplete RouteMode = "c" // Complete list of waypoints in the route
+	RouteModeWorking  RouteMode = "w" // First listed waypoint is the start of the current leg
+)
+
+type RouteMode string
+
+func (r RouteMode) Serialize() string {
+	return string(r)
+}
+
+func (r RouteMode) String() string {
+	switch r {
+	case RouteModeComplete:
+		return "Complete"
+	case RouteModeWorking:
+		return "Working"
+	default:
+		return "unknow"
+	}
+}
+
+func ParseRouteMode(raw string) (r RouteMode, err error) {
+	r = RouteMode(raw)
+	switch r {
+	case RouteModeComplete, RouteModeWorking:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
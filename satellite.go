@@ -0,0 +1,267 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Examples:
+// $GLGSV,2,1,07,65,38,045,32,66,73,308,35,72,24,145,28,74,12,031,*6B
+// $GNGSA,A,3,05,13,15,18,,,,,,,,,1.86,1.02,1.56*18
+// $GNGSA,A,3,66,65,74,,,,,,,,,,1.86,1.02,1.56*12
+
+const (
+	ConstellationGPS     Constellation = "GPS"
+	ConstellationSBAS    Constellation = "SBAS"
+	ConstellationGLONASS Constellation = "GLONASS"
+	ConstellationGalileo Constellation = "Galileo"
+	ConstellationBeiDou  Constellation = "BeiDou"
+	ConstellationQZSS    Constellation = "QZSS"
+)
+
+// Constellation is a global or regional navigation satellite system
+type Constellation string
+
+func (c Constellation) String() string {
+	switch c {
+	case ConstellationGPS, ConstellationSBAS, ConstellationGLONASS, ConstellationGalileo, ConstellationBeiDou, ConstellationQZSS:
+		return string(c)
+	default:
+		return "unknow"
+	}
+}
+
+// Prefix return the single letter identifying the constellation in RINEX format (ie: "G" for GPS)
+func (c Constellation) Prefix() string {
+	switch c {
+	case ConstellationGPS:
+		return "G"
+	case ConstellationSBAS:
+		return "S"
+	case ConstellationGLONASS:
+		return "R"
+	case ConstellationGalileo:
+		return "E"
+	case ConstellationBeiDou:
+		return "C"
+	case ConstellationQZSS:
+		return "J"
+	default:
+		return "?"
+	}
+}
+
+const (
+	SystemIDGPS     SystemID = 1
+	SystemIDGLONASS SystemID = 2
+	SystemIDGalileo SystemID = 3
+	SystemIDBeiDou  SystemID = 4
+	SystemIDQZSS    SystemID = 5
+	SystemIDNavIC   SystemID = 6
+)
+
+// SystemID is the GNSS system identifier output in GSA and GSV data fields since NMEA 4.10
+type SystemID int
+
+func (s SystemID) String() string {
+	switch s {
+	case SystemIDGPS:
+		return "GPS"
+	case SystemIDGLONASS:
+		return "GLONASS"
+	case SystemIDGalileo:
+		return "Galileo"
+	case SystemIDBeiDou:
+		return "BeiDou"
+	case SystemIDQZSS:
+		return "QZSS"
+	case SystemIDNavIC:
+		return "NavIC"
+	default:
+		return "unknow"
+	}
+}
+
+// Talker return the constellation talker numbering satellites of the system, empty if unsupported (ie: NavIC)
+func (s SystemID) Talker() TalkerID {
+	switch s {
+	case SystemIDGPS:
+		return TalkerIDGPS
+	case SystemIDGLONASS:
+		return TalkerIDGL
+	case SystemIDGalileo:
+		return TalkerIDGA
+	case SystemIDBeiDou:
+		return TalkerIDGB
+	case SystemIDQZSS:
+		return TalkerIDGQ
+	default:
+		return ""
+	}
+}
+
+func ParseSystemID(raw string) (s SystemID, err error) {
+	i, err := strconv.Atoi(raw)
+	if err != nil {
+		return
+	}
+
+	s = SystemID(i)
+	switch s {
+	case SystemIDGPS, SystemIDGLONASS, SystemIDGalileo, SystemIDBeiDou, SystemIDQZSS, SystemIDNavIC:
+	default:
+		err = fmt.Errorf("unknow system ID (got: %d)", i)
+	}
+	return
+}
+
+// sbasSystems is a dictionary of SBAS PRN assigned to each augmentation system
+var sbasSystems = map[int]string{
+	120: "EGNOS", 123: "EGNOS", 124: "EGNOS", 126: "EGNOS", 136: "EGNOS",
+	122: "SouthPAN",
+	125: "SDCM", 140: "SDCM", 141: "SDCM",
+	127: "GAGAN", 128: "GAGAN", 132: "GAGAN",
+	129: "MSAS", 137: "MSAS",
+	130: "BDSBAS", 143: "BDSBAS", 144: "BDSBAS",
+	131: "WAAS", 133: "WAAS", 135: "WAAS", 138: "WAAS",
+	134: "KASS",
+}
+
+// SatelliteID is a satellite identifier as output in GSV and GSA data fields with its constellation and native PRN
+type SatelliteID struct {
+	ID            int // Number as output in data field
+	Constellation Constellation
+	PRN           int // Native PRN of the constellation (slot number for GLONASS, 120 ~ 158 for SBAS)
+}
+
+// NewSatelliteID return satellite identifier from number output by talker according to version of the standard
+// (zero for LatestVersion)
+//
+// Constellation talkers of NMEA 4.10 and later use native PRN (GA: Galileo 1 ~ 36, GB/BD: BeiDou 1 ~ 63, QZ/GQ: QZSS 1 ~ 10),
+// otherwise numbering ranges of mixed outputs (NMEA 4.00 and earlier, GP, GL and GN talkers) are used:
+// - 1 ~ 32: GPS
+// - 33 ~ 64: SBAS (PRN 120 ~ 151)
+// - 65 ~ 96: GLONASS (slot 1 ~ 32)
+// - 120 ~ 158: SBAS
+// - 193 ~ 202: QZSS
+// - 301 ~ 336: Galileo (PRN 1 ~ 36)
+// - 401 ~ 437: BeiDou (PRN 1 ~ 37)
+func NewSatelliteID(id int, talker TalkerID, version Version) (s SatelliteID, err error) {
+	s.ID = id
+	if version == 0 {
+		version = LatestVersion
+	}
+	native := version >= Version410
+
+	switch {
+	case native && talker == TalkerIDGA && id >= 1 && id <= 36:
+		s.Constellation, s.PRN = ConstellationGalileo, id
+	case native && (talker == TalkerIDGB || talker == TalkerIDBD) && id >= 1 && id <= 63:
+		s.Constellation, s.PRN = ConstellationBeiDou, id
+	case native && (talker == TalkerIDQZ || talker == TalkerIDGQ) && id >= 1 && id <= 10:
+		s.Constellation, s.PRN = ConstellationQZSS, id+192
+	case id >= 1 && id <= 32:
+		s.Constellation, s.PRN = ConstellationGPS, id
+	case id >= 33 && id <= 64:
+		s.Constellation, s.PRN = ConstellationSBAS, id+87
+	case id >= 65 && id <= 96:
+		s.Constellation, s.PRN = ConstellationGLONASS, id-64
+	case id >= 120 && id <= 158:
+		s.Constellation, s.PRN = ConstellationSBAS, id
+	case id >= 193 && id <= 202:
+		s.Constellation, s.PRN = ConstellationQZSS, id
+	case id >= 301 && id <= 336:
+		s.Constellation, s.PRN = ConstellationGalileo, id-300
+	case id >= 401 && id <= 437:
+		s.Constellation, s.PRN = ConstellationBeiDou, id-400
+	default:
+		err = fmt.Errorf("unknow satellite ID (got: %d)", id)
+	}
+	return
+}
+
+// ParseSatelliteID return satellite identifier from data field output by talker according to version of the standard
+// (see NewSatelliteID)
+func ParseSatelliteID(raw string, talker TalkerID, version Version) (SatelliteID, error) {
+	id, err := strconv.Atoi(strings.TrimSpace(raw))
+	if err != nil {
+		return SatelliteID{}, fmt.Errorf("Unable to parse satellite ID from data field (got: %s)", raw)
+	}
+	return NewSatelliteID(id, talker, version)
+}
+
+func (s SatelliteID) Serialize() string {
+	return PrependToIntXZero(s.ID, 2)
+}
+
+// String return satellite identifier in RINEX format (ie: "G05", "R08", "S133")
+func (s SatelliteID) String() string {
+	return s.Constellation.Prefix() + PrependToIntXZero(s.PRN, 2)
+}
+
+// IsSBAS return true for a geostationary satellite of satellite based augmentation system
+func (s SatelliteID) IsSBAS() bool {
+	return s.Constellation == ConstellationSBAS
+}
+
+// SBASSystem return name of the augmentation system of SBAS satellite (ie: "WAAS", "EGNOS"), empty if unknown
+func (s SatelliteID) SBASSystem() string {
+	if !s.IsSBAS() {
+		return ""
+	}
+	return sbasSystems[s.PRN]
+}
+
+// SatelliteID return identifier of satellite in view output by talker according to version of the standard
+func (s Satellite) SatelliteID(talker TalkerID, version Version) (SatelliteID, error) {
+	return ParseSatelliteID(s.ID, talker, version)
+}
+
+// SatelliteIDs return identifiers of satellites in view of this entry according to version of the standard
+// (zero for LatestVersion, at least NMEA 4.10 when signal ID is output)
+func (m GPGSV) SatelliteIDs(version Version) ([]SatelliteID, error) {
+	talker := m.header("GSV").GetTypeID().Talker
+	if m.SignalID != nil && version != 0 && version < Version410 {
+		version = Version410
+	}
+
+	ids := make([]SatelliteID, 0, len(m.Satellites))
+	for _, s := range m.Satellites {
+		id, err := s.SatelliteID(talker, version)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+// SatellitesUsed return identifiers of satellites used in solution (empty channels skipped) according to version
+// of the standard (zero for LatestVersion)
+//
+// Numbering of the system ID output since NMEA 4.10 prevails over the talker (ie: "GN" for multi-constellation solution).
+func (m GPGSA) SatellitesUsed(version Version) ([]SatelliteID, error) {
+	talker := m.header("GSA").GetTypeID().Talker
+	if m.SystemID != 0 {
+		if talker = m.SystemID.Talker(); talker == "" {
+			return nil, fmt.Errorf("Unable to number satellites of system %s (got: %d)", m.SystemID, m.SystemID)
+		}
+		if version != 0 && version < Version410 {
+			version = Version410
+		}
+	}
+
+	ids := make([]SatelliteID, 0)
+	for _, v := range m.SatelliteUsedOnChannel[1:] {
+		if v == 0 {
+			continue
+		}
+		id, err := NewSatelliteID(v, talker, version)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,170 @@
+package nmea
+
+import "testing"
+
+func TestSatelliteID(t *testing.T) {
+	for _, c := range []struct {
+		id            int
+		talker        TalkerID
+		version       Version
+		constellation Constellation
+		prn           int
+		rinex         string
+	}{
+		{5, TalkerIDGPS, LatestVersion, ConstellationGPS, 5, "G05"},
+		{46, TalkerIDGPS, LatestVersion, ConstellationSBAS, 133, "S133"},
+		{131, TalkerIDGN, LatestVersion, ConstellationSBAS, 131, "S131"},
+		{65, TalkerIDGL, LatestVersion, ConstellationGLONASS, 1, "R01"},
+		{88, TalkerIDGN, LatestVersion, ConstellationGLONASS, 24, "R24"},
+		{193, TalkerIDGN, LatestVersion, ConstellationQZSS, 193, "J193"},
+		{311, TalkerIDGN, LatestVersion, ConstellationGalileo, 11, "E11"},
+		{420, TalkerIDGN, LatestVersion, ConstellationBeiDou, 20, "C20"},
+		{11, TalkerIDGA, LatestVersion, ConstellationGalileo, 11, "E11"},
+		{20, TalkerIDGB, LatestVersion, ConstellationBeiDou, 20, "C20"},
+		{37, TalkerIDBD, LatestVersion, ConstellationBeiDou, 37, "C37"},
+		{1, TalkerIDQZ, LatestVersion, ConstellationQZSS, 193, "J193"},
+		{2, TalkerIDGQ, LatestVersion, ConstellationQZSS, 194, "J194"},
+		{46, TalkerIDGA, LatestVersion, ConstellationSBAS, 133, "S133"},
+		{11, TalkerIDGA, 0, ConstellationGalileo, 11, "E11"},
+		// Mixed numbering before NMEA 4.10 whatever the talker
+		{311, TalkerIDGA, Version40, ConstellationGalileo, 11, "E11"},
+		{11, TalkerIDGA, Version40, ConstellationGPS, 11, "G11"},
+		{420, TalkerIDGB, Version30, ConstellationBeiDou, 20, "C20"},
+		{193, TalkerIDQZ, Version40, ConstellationQZSS, 193, "J193"},
+	} {
+		s, err := NewSatelliteID(c.id, c.talker, c.version)
+		if err != nil {
+			t.Fatalf("Unable to identify satellite %d of talker %s (NMEA %s), err: %s", c.id, c.talker, c.version, err.Error())
+		}
+		if s.Constellation != c.constellation || s.PRN != c.prn || s.String() != c.rinex {
+			t.Fatalf("Wrong satellite %d of talker %s (NMEA %s) (got: %s %d %s, wanted: %s %d %s)", c.id, c.talker, c.version, s.Constellation, s.PRN, s, c.constellation, c.prn, c.rinex)
+		}
+	}
+
+	for _, id := range []int{0, 97, 203, 300, 337, 438} {
+		if _, err := NewSatelliteID(id, TalkerIDGN, LatestVersion); err == nil {
+			t.Fatalf("Satellite ID %d should be unknown", id)
+		}
+	}
+
+	if _, err := ParseSatelliteID("x", TalkerIDGPS, LatestVersion); err == nil {
+		t.Fatal("Satellite ID \"x\" should be invalid")
+	}
+
+	if s, _ := NewSatelliteID(51, TalkerIDGPS, LatestVersion); !s.IsSBAS() || s.SBASSystem() != "WAAS" {
+		t.Fatalf("Wrong SBAS system (got: %s)", s.SBASSystem())
+	}
+	if s, _ := NewSatelliteID(5, TalkerIDGPS, LatestVersion); s.IsSBAS() || s.SBASSystem() != "" {
+		t.Fatalf("GPS satellite shouldn't be SBAS (got: %s)", s.SBASSystem())
+	}
+
+	// GLONASS satellites in view
+	raw := "$GLGSV,2,1,07,65,38,045,32,66,73,308,35,72,24,145,28,74,12,031,*6B"
+	msg, err := Parse(raw)
+	if err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+	}
+	gsv, ok := msg.(*GPGSV)
+	if !ok {
+		t.Fatalf("Wrong message type (got: %T)", msg)
+	}
+
+	ids, err := gsv.SatelliteIDs(LatestVersion)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, rinex := range []string{"R01", "R02", "R08", "R10"} {
+		if ids[i].String() != rinex || ids[i].Serialize() != gsv.Satellites[i].ID {
+			t.Fatalf("Wrong satellite in view at %d (got: %s, wanted: %s)", i, ids[i], rinex)
+		}
+	}
+
+	// Mixed GPS and GLONASS satellites used
+	raw = "$GNGSA,A,3,66,65,74,,,,,,,,,,1.86,1.02,1.56*12"
+	if msg, err = Parse(raw); err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+	}
+	gsa, ok := msg.(*GPGSA)
+	if !ok {
+		t.Fatalf("Wrong message type (got: %T)", msg)
+	}
+
+	if ids, err = gsa.SatellitesUsed(LatestVersion); err != nil {
+		t.Fatal(err)
+	}
+	if len(ids) != 3 || ids[0].String() != "R02" || ids[1].String() != "R01" || ids[2].String() != "R10" {
+		t.Fatalf("Wrong satellites used (got: %v)", ids)
+	}
+
+	// NMEA 4.10 system ID numbering prevails over mixed talker
+	for _, c := range []struct {
+		raw   string
+		used  []string
+		valid bool
+	}{
+		{"$GNGSA,A,3,80,71,73,79,69,,,,,,,,1.83,1.09,1.47,2*09", []string{"R16", "R07", "R09", "R15", "R05"}, true},
+		{"$GNGSA,A,3,13,05,15,18,,,,,,,,,1.86,1.02,1.56,3*07", []string{"E13", "E05", "E15", "E18"}, true},
+		{"$GNGSA,A,3,13,05,,,,,,,,,,,1.86,1.02,1.56,4*0D", []string{"C13", "C05"}, true},
+		{"$GQGSA,A,3,02,03,,,,,,,,,,,1.86,1.02,1.56,5*15", []string{"J194", "J195"}, true},
+		{"$GNGSA,A,3,05,,,,,,,,,,,,1.86,1.02,1.56,6*0D", nil, false},
+	} {
+		if msg, err = Parse(c.raw); err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", c.raw, err.Error())
+		}
+		gsa := msg.(*GPGSA)
+
+		ids, err = gsa.SatellitesUsed(LatestVersion)
+		if !c.valid {
+			if err == nil {
+				t.Fatalf("Satellites used of system %s should be unknown (got: %v)", gsa.SystemID, ids)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(ids) != len(c.used) {
+			t.Fatalf("Wrong satellites used of system %s (got: %v, wanted: %v)", gsa.SystemID, ids, c.used)
+		}
+		for i, rinex := range c.used {
+			if ids[i].String() != rinex {
+				t.Fatalf("Wrong satellite used of system %s at %d (got: %s, wanted: %s)", gsa.SystemID, i, ids[i], rinex)
+			}
+		}
+	}
+
+	// System ID is only output since NMEA 4.10 (native numbering)
+	if msg, err = Parse("$GNGSA,A,3,13,05,15,18,,,,,,,,,1.86,1.02,1.56,3*07"); err != nil {
+		t.Fatal(err)
+	}
+	if ids, err = msg.(*GPGSA).SatellitesUsed(Version40); err != nil || ids[0].String() != "E13" {
+		t.Fatalf("Wrong Galileo satellite used with system ID (got: %v, err: %v)", ids, err)
+	}
+
+	// System ID is a decimal data field
+	for raw, valid := range map[string]bool{"1": true, "6": true, "A": false, "0x3": false, "10": false} {
+		if _, err := ParseSystemID(raw); (err == nil) != valid {
+			t.Fatalf("Wrong validity of system ID \"%s\" (wanted: %t, err: %v)", raw, valid, err)
+		}
+	}
+	if v := Version410.String(); v != "4.10" {
+		t.Fatalf("Wrong NMEA version (got: %s)", v)
+	}
+
+	if _, err = Parse("$GNGSA,A,3,05,,,,,,,,,,,,1.86,1.02,1.56,9*02"); err == nil {
+		t.Fatal("GSA with unknown system ID should be invalid")
+	}
+
+	// NMEA 4.10 signal ID of satellites in view
+	raw = "$GAGSV,1,1,03,02,38,077,41,08,21,152,37,30,54,301,44,7*45"
+	if msg, err = Parse(raw); err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+	}
+	gsv = msg.(*GPGSV)
+	if gsv.SignalID == nil || *gsv.SignalID != 7 || len(gsv.Satellites) != 3 {
+		t.Fatalf("Wrong satellites in view with signal ID (got: %v, %v)", gsv.SignalID, gsv.Satellites)
+	}
+	if ids, err = gsv.SatelliteIDs(LatestVersion); err != nil || ids[2].String() != "E30" {
+		t.Fatalf("Wrong Galileo satellite in view (got: %v, err: %v)", ids, err)
+	}
+}
@@ -0,0 +1,94 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// Examples:
+// $INTHS,77.5,A*12
+
+func NewTHS(m Message) *THS {
+	return &THS{Message: m}
+}
+
+type THS struct {
+	Message
+
+	Heading *float64 // Heading (true) in degree, empty when data is not valid
+	Mode    HeadingMode
+}
+
+func (m *THS) parse() (err error) {
+	if len(m.Fields) != 2 {
+		return m.Error(fmt.Errorf("Incomplete THS message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 2))
+	}
+
+	if heading := m.Fields[0]; len(heading) > 0 {
+		h, err := strconv.ParseFloat(heading, 64)
+		if err != nil {
+			return m.Error(fmt.Errorf("Unable to parse true heading from data field (got: %s)", heading))
+		}
+		m.Heading = &h
+	}
+
+	if m.Mode, err = ParseHeadingMode(m.Fields[1]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse heading mode indicator from data field (got: %s)", m.Fields[1]))
+	}
+
+	return nil
+}
+
+func (m THS) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("THS")
+	fields := make([]string, 0)
+
+	fields = append(fields, formatOptionalFloat(m.Heading, m.field(0), "%.1f"), m.Mode.Serialize())
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	HeadingModeAutonomous HeadingMode = "A"
+	HeadingModeEstimated  HeadingMode = "E" // Dead reckoning
+	HeadingModeManual     HeadingMode = "M"
+	HeadingModeSimulator  HeadingMode = "S"
+	HeadingModeInvalid    HeadingMode = "V"
+)
+
+type HeadingMode string
+
+func (h HeadingMode) Serialize() string {
+	return string(h)
+}
+
+func (h HeadingMode) String() string {
+	switch h {
+	case HeadingModeAutonomous:
+		return "Autonomous"
+	case HeadingModeEstimated:
+		return "Estimated (dead reckoning)"
+	case HeadingModeManual:
+		return "Manual input"
+	case HeadingModeSimulator:
+		return "Simulator"
+	case HeadingModeInvalid:
+		return "Data not valid"
+	default:
+		return "unknow"
+	}
+}
+
+func ParseHeadingMode(raw string) (h HeadingMode, err error) {
+	h = HeadingMode(raw)
+	switch h {
+	case HeadingModeAutonomous, HeadingModeEstimated, HeadingModeManual, HeadingModeSimulator, HeadingModeInvalid:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
@@ -0,0 +1,84 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Examples:
+// $RATLL,01,4917.24,N,12309.57,W,TGT01,225444.00,T,*78
+
+func NewTLL(m Message) *TLL {
+	return &TLL{Message: m}
+}
+
+// TLL is the latitude and longitude of a tracked target
+type TLL struct {
+	Message
+
+	TargetNumber      int     // Target number (0 ~ 99)
+	Latitude          LatLong // In decimal format
+	Longitude         LatLong // In decimal format
+	LatitudeDecimals  *int    // Number of decimals of minutes in latitude data field (NoCoordinate if empty), DefaultCoordinateDecimals if not specified (nil)
+	LongitudeDecimals *int    // Number of decimals of minutes in longitude data field (NoCoordinate if empty), DefaultCoordinateDecimals if not specified (nil)
+	Name              string
+	TimeUTC           time.Time // UTC of data, zero if not available
+	TimeDigits        int       // Number of fractional digits of TimeUTC data field
+	Status            TargetStatus
+	IsReference       bool // Reference target (used to determine own ship speed)
+}
+
+func (m *TLL) parse() (err error) {
+	if len(m.Fields) != 9 {
+		return m.Error(fmt.Errorf("Incomplete TLL message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 9))
+	}
+
+	if m.TargetNumber, err = strconv.Atoi(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse target number from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.Latitude, m.LatitudeDecimals, err = parseDMField(m.Fields[1], m.Fields[2]); err != nil {
+		return m.Error(err)
+	}
+
+	if m.Longitude, m.LongitudeDecimals, err = parseDMField(m.Fields[3], m.Fields[4]); err != nil {
+		return m.Error(err)
+	}
+
+	m.Name = m.Fields[5]
+
+	if m.TimeUTC, m.TimeDigits, err = parseTimeOfDay(m.Fields[6]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[6]))
+	}
+
+	if m.Status, err = ParseTargetStatus(m.Fields[7]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse target status from data field (got: %s)", m.Fields[7]))
+	}
+
+	m.IsReference = (m.Fields[8] == "R")
+
+	return nil
+}
+
+func (m TLL) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("TLL")
+	fields := make([]string, 0)
+
+	fields = append(fields, PrependToIntXZero(m.TargetNumber, 2))
+	fields = append(fields, serializeDMField(m.Latitude, true, m.LatitudeDecimals)...)
+	fields = append(fields, serializeDMField(m.Longitude, false, m.LongitudeDecimals)...)
+	fields = append(fields, m.Name, serializeTimeOfDay(m.TimeUTC, m.TimeDigits), m.Status.Serialize())
+
+	if m.IsReference {
+		fields = append(fields, "R")
+	} else {
+		fields = append(fields, "")
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,201 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Examples:
+// $RATTM,11,11.4,13.6,T,7.0,20.0,T,0.2,-0.8,N,TGT11,T,,100021.00,A*50
+// $RATTM,02,2.5,268.1,R,0.0,0.0,T,2.5,,N,,Q,R*18
+
+func NewTTM(m Message) *TTM {
+	return &TTM{Message: m}
+}
+
+// TTM is a tracked target message from a radar (ARPA), distances and speeds are expressed according to Unit
+type TTM struct {
+	Message
+
+	TargetNumber    int      // Target number (0 ~ 99)
+	Distance        *float64 // Target distance from own ship, empty if not available
+	Bearing         *float64 // Bearing from own ship in degree, empty if not available
+	BearingRef      BearingReference
+	Speed           *float64 // Target speed, empty if not available
+	Course          *float64 // Target course in degree, empty if not available
+	CourseRef       BearingReference
+	CPA             *float64 // Distance of closest point of approach, empty if not available
+	TCPA            *float64 // Time to closest point of approach in minutes (negative means increasing), empty if not available
+	Unit            SpeedUnit
+	Name            string
+	Status          TargetStatus
+	IsReference     bool            // Reference target (used to determine own ship speed)
+	TimeUTC         time.Time       // UTC of data (NMEA 3.0 and later), zero if not available
+	TimeDigits      int             // Number of fractional digits of TimeUTC data field
+	AcquisitionType AcquisitionType // NMEA 3.0 and later, empty if not available
+}
+
+func (m *TTM) parse() (err error) {
+	if len(m.Fields) != 13 && len(m.Fields) != 15 {
+		return m.Error(fmt.Errorf("Incomplete TTM message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 13, 15))
+	}
+
+	if m.TargetNumber, err = strconv.Atoi(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse target number from data field (got: %s)", m.Fields[0]))
+	}
+
+	for i, v := range map[int]**float64{
+		1: &m.Distance,
+		2: &m.Bearing,
+		4: &m.Speed,
+		5: &m.Course,
+		7: &m.CPA,
+		8: &m.TCPA,
+	} {
+		if *v, err = parseOptionalFloat(m.Fields[i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse data field at %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	if m.BearingRef, err = ParseBearingReference(m.Fields[3]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse bearing reference from data field (got: %s)", m.Fields[3]))
+	}
+
+	if m.CourseRef, err = ParseBearingReference(m.Fields[6]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse course reference from data field (got: %s)", m.Fields[6]))
+	}
+
+	if m.Unit, err = ParseSpeedUnit(m.Fields[9]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse speed/distance unit from data field (got: %s)", m.Fields[9]))
+	}
+
+	m.Name = m.Fields[10]
+
+	if m.Status, err = ParseTargetStatus(m.Fields[11]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse target status from data field (got: %s)", m.Fields[11]))
+	}
+
+	m.IsReference = (m.Fields[12] == "R")
+
+	if len(m.Fields) == 15 {
+		if m.TimeUTC, m.TimeDigits, err = parseTimeOfDay(m.Fields[13]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[13]))
+		}
+
+		if len(m.Fields[14]) > 0 {
+			if m.AcquisitionType, err = ParseAcquisitionType(m.Fields[14]); err != nil {
+				return m.Error(fmt.Errorf("Unable to parse acquisition type from data field (got: %s)", m.Fields[14]))
+			}
+		}
+	}
+
+	return nil
+}
+
+func (m TTM) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("TTM")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		PrependToIntXZero(m.TargetNumber, 2),
+		formatOptionalFloat(m.Distance, m.field(1), "%.1f"),
+		formatOptionalFloat(m.Bearing, m.field(2), "%.1f"),
+		m.BearingRef.Serialize(),
+		formatOptionalFloat(m.Speed, m.field(4), "%.1f"),
+		formatOptionalFloat(m.Course, m.field(5), "%.1f"),
+		m.CourseRef.Serialize(),
+		formatOptionalFloat(m.CPA, m.field(7), "%.1f"),
+		formatOptionalFloat(m.TCPA, m.field(8), "%.1f"),
+		m.Unit.Serialize(),
+		m.Name,
+		m.Status.Serialize(),
+	)
+
+	if m.IsReference {
+		fields = append(fields, "R")
+	} else {
+		fields = append(fields, "")
+	}
+
+	// Keep NMEA 3.0 data fields when parsed even if empty
+	if !m.TimeUTC.IsZero() || len(m.AcquisitionType) > 0 || len(m.Fields) == 15 {
+		fields = append(fields, serializeTimeOfDay(m.TimeUTC, m.TimeDigits), m.AcquisitionType.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+const (
+	TargetStatusLost     TargetStatus = "L" // Lost, tracked target has been lost
+	TargetStatusQuery    TargetStatus = "Q" // Query, target in the process of acquisition
+	TargetStatusTracking TargetStatus = "T" // Tracking
+)
+
+type TargetStatus string
+
+func (t TargetStatus) Serialize() string {
+	return string(t)
+}
+
+func (t TargetStatus) String() string {
+	switch t {
+	case TargetStatusLost:
+		return "Lost"
+	case TargetStatusQuery:
+		return "Query"
+	case TargetStatusTracking:
+		return "Tracking"
+	default:
+		return "unknow"
+	}
+}
+
+func ParseTargetStatus(raw string) (t TargetStatus, err error) {
+	t = TargetStatus(raw)
+	switch t {
+	case TargetStatusLost, TargetStatusQuery, TargetStatusTracking:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	AcquisitionAutomatic AcquisitionType = "A"
+	AcquisitionManual    AcquisitionType = "M"
+	AcquisitionReported  AcquisitionType = "R"
+)
+
+type AcquisitionType string
+
+func (a AcquisitionType) Serialize() string {
+	return string(a)
+}
+
+func (a AcquisitionType) String() string {
+	switch a {
+	case AcquisitionAutomatic:
+		return "Automatic"
+	case AcquisitionManual:
+		return "Manual"
+	case AcquisitionReported:
+		return "Reported"
+	default:
+		return "unknow"
+	}
+}
+
+func ParseAcquisitionType(raw string) (a AcquisitionType, err error) {
+	a = AcquisitionType(raw)
+	switch a {
+	case AcquisitionAutomatic, AcquisitionManual, AcquisitionReported:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
@@ -0,0 +1,131 @@
+package nmea
+
+import "fmt"
+
+const (
+	// Speed conversion factors
+	// KnotInMetersPerSecond is the value of one knot in meters per second
+	KnotInMetersPerSecond = 1852.0 / 3600.0
+	// KmhInMetersPerSecond is the value of one km/h in meters per second
+	KmhInMetersPerSecond = 1000.0 / 3600.0
+	// MphInMetersPerSecond is the value of one statute mile per hour in meters per second
+	MphInMetersPerSecond = 1609.344 / 3600.0
+)
+
+const (
+	SpeedUnitKmh          SpeedUnit = "K"
+	SpeedUnitMetersPerSec SpeedUnit = "M"
+	SpeedUnitKnots        SpeedUnit = "N"
+	SpeedUnitMph          SpeedUnit = "S"
+)
+
+type SpeedUnit string
+
+func (u SpeedUnit) Serialize() string {
+	return string(u)
+}
+
+func (u SpeedUnit) String() string {
+	switch u {
+	case SpeedUnitKmh:
+		return "km/h"
+	case SpeedUnitMetersPerSec:
+		return "m/s"
+	case SpeedUnitKnots:
+		return "knots"
+	case SpeedUnitMph:
+		return "mph"
+	default:
+		return "unknow"
+	}
+}
+
+func ParseSpeedUnit(raw string) (u SpeedUnit, err error) {
+	u = SpeedUnit(raw)
+	switch u {
+	case SpeedUnitKmh, SpeedUnitMetersPerSec, SpeedUnitKnots, SpeedUnitMph:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+// ToMetersPerSecond convert speed expressed in this unit to meters per second
+func (u SpeedUnit) ToMetersPerSecond(v float64) float64 {
+	switch u {
+	case SpeedUnitKmh:
+		return v * KmhInMetersPerSecond
+	case SpeedUnitKnots:
+		return v * KnotInMetersPerSecond
+	case SpeedUnitMph:
+		return v * MphInMetersPerSecond
+	default:
+		return v
+	}
+}
+
+// ToKnots convert speed expressed in this unit to knots
+func (u SpeedUnit) ToKnots(v float64) float64 {
+	return u.ToMetersPerSecond(v) / KnotInMetersPerSecond
+}
+
+// ToKmh convert speed expressed in this unit to km/h
+func (u SpeedUnit) ToKmh(v float64) float64 {
+	return u.ToMetersPerSecond(v) / KmhInMetersPerSecond
+}
+
+const (
+	// Length conversion factors
+	// FootInMeters is the value of one foot in meters
+	FootInMeters = 0.3048
+	// FathomInMeters is the value of one fathom (6 feet) in meters
+	FathomInMeters = 6 * FootInMeters
+)
+
+const (
+	DepthUnitFeet    DepthUnit = "f"
+	DepthUnitMeters  DepthUnit = "M"
+	DepthUnitFathoms DepthUnit = "F"
+)
+
+type DepthUnit string
+
+func (u DepthUnit) Serialize() string {
+	return string(u)
+}
+
+func (u DepthUnit) String() string {
+	switch u {
+	case DepthUnitFeet:
+		return "feet"
+	case DepthUnitMeters:
+		return "meters"
+	case DepthUnitFathoms:
+		return "fathoms"
+	default:
+		return "unknow"
+	}
+}
+
+// ToMeters convert depth expressed in this unit to meters
+func (u DepthUnit) ToMeters(v float64) float64 {
+	switch u {
+	case DepthUnitFeet:
+		return v * FootInMeters
+	case DepthUnitFathoms:
+		return v * FathomInMeters
+	default:
+		return v
+	}
+}
+
+// FromMeters convert depth expressed in meters to this unit
+func (u DepthUnit) FromMeters(v float64) float64 {
+	return v / u.ToMeters(1)
+}
+
+const (
+	// Pressure conversion factors
+	// InchOfMercuryInPascal is the value of one inch of mercury in pascal
+	InchOfMercuryInPascal = 3386.389
+)
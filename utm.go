@@ -0,0 +1,317 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	// UTMScaleFactor is the scale factor on central meridian
+	UTMScaleFactor = 0.9996
+	// UTMFalseEasting is added to easting to avoid negative values
+	UTMFalseEasting = 500000.0
+	// UTMFalseNorthing is added to northing in southern hemisphere to avoid negative values
+	UTMFalseNorthing = 10000000.0
+)
+
+var (
+	// Latitude bands of 8 degree from 80°S to 84°N (X band is 12 degree)
+	utmBands = "CDEFGHJKLMNPQRSTUVWXX"
+
+	// MGRS 100km square letters, columns depend on zone modulo 3 and rows on zone parity
+	mgrsColumns = [3]string{"ABCDEFGH", "JKLMNPQR", "STUVWXYZ"}
+	mgrsRows    = "ABCDEFGHJKLMNPQRSTUV"
+
+	MGRSFormat = regexp.MustCompile(`^([0-9]{1,2})\ ?([C-HJ-NP-X])\ ?([A-HJ-NP-Z])([A-HJ-NP-V])\ ?([0-9]*)\ ?([0-9]*)$`)
+)
+
+// UTM is a position in Universal Transverse Mercator projection (WGS84 ellipsoid)
+type UTM struct {
+	Zone     int     // Longitude zone of 6 degree (1 to 60)
+	Band     byte    // Latitude band letter (C to X, N and above are in northern hemisphere)
+	Easting  float64 // In meter with false easting
+	Northing float64 // In meter with false northing in southern hemisphere
+}
+
+func (u UTM) String() string {
+	return fmt.Sprintf("%d%c %.0f %.0f", u.Zone, u.Band, math.Floor(u.Easting), math.Floor(u.Northing))
+}
+
+// IsNorthern return true if position is in northern hemisphere
+func (u UTM) IsNorthern() bool {
+	return u.Band >= 'N'
+}
+
+// krugerCoefficients return the scaled radius and the series coefficients (order 6) of Krüger's formulae
+// used by forward (alpha) and inverse (beta) transverse Mercator projection (see Karney 2011)
+func krugerCoefficients() (A float64, alpha, beta [6]float64) {
+	n := WGS84Flattening / (2 - WGS84Flattening)
+	n2, n3 := n*n, n*n*n
+	n4, n5, n6 := n3*n, n3*n2, n3*n3
+
+	A = WGS84SemiMajorAxis / (1 + n) * (1 + n2/4 + n4/64 + n6/256)
+
+	alpha = [6]float64{
+		1./2*n - 2./3*n2 + 5./16*n3 + 41./180*n4 - 127./288*n5 + 7891./37800*n6,
+		13./48*n2 - 3./5*n3 + 557./1440*n4 + 281./630*n5 - 1983433./1935360*n6,
+		61./240*n3 - 103./140*n4 + 15061./26880*n5 + 167603./181440*n6,
+		49561./161280*n4 - 179./168*n5 + 6601661./7257600*n6,
+		34729./80640*n5 - 3418889./1995840*n6,
+		212378941. / 319334400 * n6,
+	}
+	beta = [6]float64{
+		1./2*n - 2./3*n2 + 37./96*n3 - 1./360*n4 - 81./512*n5 + 96199./604800*n6,
+		1./48*n2 + 1./15*n3 - 437./1440*n4 + 46./105*n5 - 1118711./3870720*n6,
+		17./480*n3 - 37./840*n4 - 209./4480*n5 + 5569./90720*n6,
+		4397./161280*n4 - 11./504*n5 - 830251./7257600*n6,
+		4583./161280*n5 - 108847./3991680*n6,
+		20648693. / 638668800 * n6,
+	}
+	return
+}
+
+// centralMeridian return longitude in radian of the central meridian of a zone
+func centralMeridian(zone int) float64 {
+	return float64((zone-1)*6-180+3) * math.Pi / 180
+}
+
+// UTM return position in Universal Transverse Mercator projection, error if latitude is out of 80°S to 84°N
+func (p Position) UTM() (UTM, error) {
+	if p.Latitude < -80 || p.Latitude > 84 {
+		return UTM{}, fmt.Errorf("Latitude out of UTM limits (got: %f)", p.Latitude)
+	}
+
+	lat, lon := float64(p.Latitude), float64(p.Longitude)
+	zone := int(math.Floor((lon+180)/6)) + 1
+	if zone > 60 {
+		zone = 1 // 180°E is 180°W
+	}
+
+	// Norway and Svalbard exceptions
+	switch {
+	case zone == 31 && lat >= 56 && lat < 64 && lon >= 3:
+		zone = 32
+	case zone == 32 && lat >= 72:
+		if zone = 31; lon >= 9 {
+			zone = 33
+		}
+	case zone == 34 && lat >= 72:
+		if zone = 33; lon >= 21 {
+			zone = 35
+		}
+	case zone == 36 && lat >= 72:
+		if zone = 35; lon >= 33 {
+			zone = 37
+		}
+	}
+
+	return p.utm(zone), nil
+}
+
+// utm return position projected in a given zone
+func (p Position) utm(zone int) UTM {
+	A, alpha, _ := krugerCoefficients()
+	e := math.Sqrt(WGS84Eccentricity2)
+
+	phi, lambda := p.radians()
+	lambda -= centralMeridian(zone)
+
+	tau := math.Tan(phi)
+	sigma := math.Sinh(e * math.Atanh(e*tau/math.Sqrt(1+tau*tau)))
+	tauPrime := tau*math.Sqrt(1+sigma*sigma) - sigma*math.Sqrt(1+tau*tau)
+
+	xiPrime := math.Atan2(tauPrime, math.Cos(lambda))
+	etaPrime := math.Asinh(math.Sin(lambda) / math.Sqrt(tauPrime*tauPrime+math.Cos(lambda)*math.Cos(lambda)))
+
+	xi, eta := xiPrime, etaPrime
+	for j := 1; j <= 6; j++ {
+		xi += alpha[j-1] * math.Sin(2*float64(j)*xiPrime) * math.Cosh(2*float64(j)*etaPrime)
+		eta += alpha[j-1] * math.Cos(2*float64(j)*xiPrime) * math.Sinh(2*float64(j)*etaPrime)
+	}
+
+	u := UTM{
+		Zone:     zone,
+		Band:     utmBands[int(math.Floor(float64(p.Latitude)/8+10))],
+		Easting:  UTMScaleFactor*A*eta + UTMFalseEasting,
+		Northing: UTMScaleFactor * A * xi,
+	}
+	if p.Latitude < 0 {
+		u.Northing += UTMFalseNorthing
+	}
+	return u
+}
+
+// Position return position of UTM coordinates, error if zone or band is invalid
+func (u UTM) Position() (Position, error) {
+	if u.Zone < 1 || u.Zone > 60 {
+		return Position{}, fmt.Errorf("Invalid UTM zone (got: %d)", u.Zone)
+	}
+	if !strings.ContainsRune(utmBands, rune(u.Band)) {
+		return Position{}, fmt.Errorf("Invalid UTM band (got: %c)", u.Band)
+	}
+
+	A, _, beta := krugerCoefficients()
+	e2 := WGS84Eccentricity2
+	e := math.Sqrt(e2)
+
+	y := u.Northing
+	if !u.IsNorthern() {
+		y -= UTMFalseNorthing
+	}
+	xi, eta := y/(UTMScaleFactor*A), (u.Easting-UTMFalseEasting)/(UTMScaleFactor*A)
+
+	xiPrime, etaPrime := xi, eta
+	for j := 1; j <= 6; j++ {
+		xiPrime -= beta[j-1] * math.Sin(2*float64(j)*xi) * math.Cosh(2*float64(j)*eta)
+		etaPrime -= beta[j-1] * math.Cos(2*float64(j)*xi) * math.Sinh(2*float64(j)*eta)
+	}
+
+	sinhEtaPrime := math.Sinh(etaPrime)
+	sinXiPrime, cosXiPrime := math.Sincos(xiPrime)
+	tauPrime := sinXiPrime / math.Sqrt(sinhEtaPrime*sinhEtaPrime+cosXiPrime*cosXiPrime)
+
+	// Newton-Raphson iterations to reverse conformal latitude
+	tau := tauPrime
+	for i := 0; i < 10; i++ {
+		sigma := math.Sinh(e * math.Atanh(e*tau/math.Sqrt(1+tau*tau)))
+		t := tau*math.Sqrt(1+sigma*sigma) - sigma*math.Sqrt(1+tau*tau)
+		delta := (tauPrime - t) / math.Sqrt(1+t*t) * (1 + (1-e2)*tau*tau) / ((1 - e2) * math.Sqrt(1+tau*tau))
+		if tau += delta; math.Abs(delta) < 1e-12 {
+			break
+		}
+	}
+
+	lambda := math.Atan2(sinhEtaPrime, cosXiPrime) + centralMeridian(u.Zone)
+	return positionFromRadians(math.Atan(tau), lambda), nil
+}
+
+// MGRS is a position in Military Grid Reference System (WGS84 ellipsoid)
+type MGRS struct {
+	Zone     int     // Longitude zone of 6 degree (1 to 60)
+	Band     byte    // Latitude band letter (C to X)
+	Column   byte    // 100km square column letter
+	Row      byte    // 100km square row letter
+	Easting  float64 // In meter within 100km square
+	Northing float64 // In meter within 100km square
+}
+
+// Format return MGRS reference with expected number of digits for easting and northing (0 to 5, ie: 5 for 1m precision)
+func (m MGRS) Format(digits int) string {
+	if digits < 0 {
+		digits = 0
+	} else if digits > 5 {
+		digits = 5
+	}
+
+	rv := fmt.Sprintf("%d%c %c%c", m.Zone, m.Band, m.Column, m.Row)
+	if digits > 0 {
+		div := math.Pow(10, float64(5-digits))
+		rv += fmt.Sprintf(" %0*d %0*d", digits, int(math.Floor(m.Easting/div)), digits, int(math.Floor(m.Northing/div)))
+	}
+	return rv
+}
+
+func (m MGRS) String() string {
+	return m.Format(5)
+}
+
+// ParseMGRS parses MGRS reference with or without spaces (ie: "31U DQ 48251 11932" or "31UDQ4825111932")
+func ParseMGRS(raw string) (m MGRS, err error) {
+	matches := MGRSFormat.FindStringSubmatch(strings.ToUpper(strings.TrimSpace(raw)))
+	if matches == nil {
+		return m, fmt.Errorf("Wrong MGRS format (got: \"%s\")", raw)
+	}
+
+	digits := matches[5] + matches[6]
+	if len(digits)%2 != 0 || len(digits) > 10 {
+		return m, fmt.Errorf("Wrong MGRS format, easting and northing should have the same number of digits (got: \"%s\")", raw)
+	}
+
+	m.Zone, _ = strconv.Atoi(matches[1])
+	if m.Zone < 1 || m.Zone > 60 {
+		return m, fmt.Errorf("Invalid MGRS zone (got: %d)", m.Zone)
+	}
+	m.Band, m.Column, m.Row = matches[2][0], matches[3][0], matches[4][0]
+
+	if half := len(digits) / 2; half > 0 {
+		scale := math.Pow(10, float64(5-half))
+		e, _ := strconv.Atoi(digits[:half])
+		n, _ := strconv.Atoi(digits[half:])
+		m.Easting, m.Northing = float64(e)*scale, float64(n)*scale
+	}
+	return m, nil
+}
+
+// MGRS return UTM coordinates in Military Grid Reference System
+func (u UTM) MGRS() MGRS {
+	column := int(math.Floor(u.Easting / 100000))
+	row := int(math.Floor(u.Northing/100000)) % 20
+
+	offset := 0
+	if u.Zone%2 == 0 {
+		offset = 5
+	}
+
+	return MGRS{
+		Zone:     u.Zone,
+		Band:     u.Band,
+		Column:   mgrsColumns[(u.Zone-1)%3][(column-1+8)%8],
+		Row:      mgrsRows[(row+offset)%20],
+		Easting:  math.Mod(u.Easting, 100000),
+		Northing: math.Mod(u.Northing, 100000),
+	}
+}
+
+// UTM return UTM coordinates of MGRS reference, error if letters are invalid for the zone
+func (m MGRS) UTM() (UTM, error) {
+	if m.Zone < 1 || m.Zone > 60 {
+		return UTM{}, fmt.Errorf("Invalid MGRS zone (got: %d)", m.Zone)
+	}
+
+	band := strings.IndexByte(utmBands, m.Band)
+	column := strings.IndexByte(mgrsColumns[(m.Zone-1)%3], m.Column)
+	row := strings.IndexByte(mgrsRows, m.Row)
+	if band < 0 || column < 0 || row < 0 {
+		return UTM{}, fmt.Errorf("Invalid MGRS letters for zone %d (got: %c%c%c)", m.Zone, m.Band, m.Column, m.Row)
+	}
+
+	if m.Zone%2 == 0 {
+		row = (row - 5 + 20) % 20
+	}
+
+	u := UTM{
+		Zone:     m.Zone,
+		Band:     m.Band,
+		Easting:  float64(column+1)*100000 + m.Easting,
+		Northing: float64(row)*100000 + m.Northing,
+	}
+
+	// Resolve northing modulo 2000km with the lowest northing of the latitude band
+	bottom := Position{Latitude: Latitude(band*8 - 80), Longitude: Longitude(centralMeridian(m.Zone) * 180 / math.Pi)}.utm(m.Zone)
+	minNorthing := math.Floor(bottom.Northing/100000) * 100000
+	for u.Northing < minNorthing {
+		u.Northing += 2000000
+	}
+	return u, nil
+}
+
+// MGRS return position in Military Grid Reference System, error if latitude is out of 80°S to 84°N
+func (p Position) MGRS() (MGRS, error) {
+	u, err := p.UTM()
+	if err != nil {
+		return MGRS{}, err
+	}
+	return u.MGRS(), nil
+}
+
+// Position return position of the south-west corner of the MGRS reference
+func (m MGRS) Position() (Position, error) {
+	u, err := m.UTM()
+	if err != nil {
+		return Position{}, err
+	}
+	return u.Position()
+}
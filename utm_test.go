@@ -0,0 +1,165 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+)
+
+func TestECEF(t *testing.T) {
+	for _, test := range []struct {
+		position Position
+		altitude float64
+		expected ECEF
+	}{
+		{Position{}, 0, ECEF{X: WGS84SemiMajorAxis}},
+		{Position{Longitude: 90}, 100, ECEF{Y: WGS84SemiMajorAxis + 100}},
+		{Position{Latitude: 90}, 0, ECEF{Z: WGS84SemiMinorAxis}},
+		{Position{Latitude: -90}, -10, ECEF{Z: -WGS84SemiMinorAxis + 10}},
+	} {
+		e := test.position.ECEF(test.altitude)
+		if math.Abs(e.X-test.expected.X) > 1e-6 || math.Abs(e.Y-test.expected.Y) > 1e-6 || math.Abs(e.Z-test.expected.Z) > 1e-6 {
+			t.Fatalf("Wrong ECEF coordinates of %+v (got: %+v, wanted: %+v)", test.position, e, test.expected)
+		}
+	}
+
+	// Round-trip
+	for _, p := range []Position{
+		{Latitude: 48.8583701, Longitude: 2.2944813},
+		{Latitude: -33.8567844, Longitude: 151.2152967},
+		{Latitude: 89.9999, Longitude: -179.5},
+		{Latitude: 0, Longitude: 0},
+	} {
+		for _, altitude := range []float64{-400, 0, 8848, 20200e3} {
+			back, h := p.ECEF(altitude).Position()
+			if math.Abs(float64(back.Latitude-p.Latitude)) > 1e-9 || math.Abs(float64(back.Longitude-p.Longitude)) > 1e-9 || math.Abs(h-altitude) > 1e-4 {
+				t.Fatalf("Wrong ECEF round-trip of %+v at %.0fm (got: %+v at %fm)", p, altitude, back, h)
+			}
+		}
+	}
+
+	// Fix at its ellipsoidal height
+	msg, err := Parse("$GPGGA,015540.000,3150.68378,N,11711.93139,E,1,17,0.6,0051.6,M,0.0,M,,*58")
+	if err != nil {
+		t.Fatal(err)
+	}
+	gga := msg.(*GPGGA)
+	separation := -20.5
+	gga.GeoIDSep = &separation
+	if e, err := gga.ECEF(); err != nil || e != gga.Position().ECEF(51.6-20.5) {
+		t.Fatalf("Wrong ECEF coordinates of fix (got: %+v, err: %v)", e, err)
+	}
+
+	gga.GeoIDSep = nil
+	if _, err := gga.ECEF(); err == nil {
+		t.Fatal("ECEF coordinates shouldn't be computed without geoid separation")
+	}
+}
+
+func TestENU(t *testing.T) {
+	origin := Position{Latitude: 45.764043, Longitude: 4.835659}
+
+	// Position one kilometer north on the same meridian
+	north, _ := origin.Destination(0, 1000)
+	enu := north.ECEF(0).ENU(origin, 0)
+	if math.Abs(enu.East) > 1e-6 || math.Abs(enu.North-1000) > 0.01 || enu.Up > 0 {
+		t.Fatalf("Wrong ENU coordinates (got: %+v)", enu)
+	}
+
+	// Round-trip
+	for _, n := range []ENU{{East: 1200, North: -300, Up: 50}, {East: -25e3, North: 40e3, Up: -120}} {
+		p, h := n.Position(origin, 200)
+		back := p.ECEF(h).ENU(origin, 200)
+		if math.Abs(back.East-n.East) > 1e-6 || math.Abs(back.North-n.North) > 1e-6 || math.Abs(back.Up-n.Up) > 1e-6 {
+			t.Fatalf("Wrong ENU round-trip (got: %+v, wanted: %+v)", back, n)
+		}
+	}
+}
+
+func TestUTM(t *testing.T) {
+	for _, test := range []struct {
+		position Position
+		expected UTM
+		mgrs     string
+	}{
+		// Values cross-checked with an independent transverse Mercator implementation
+		{Position{Latitude: 48.8583701, Longitude: 2.2944813}, UTM{31, 'U', 448250.599, 5411951.599}, "31U DQ 48250 11951"},
+		{Position{Latitude: -79.9, Longitude: -179.9}, UTM{1, 'C', 443247.872, 1128161.373}, "1C DM 43247 28161"},
+		{Position{Latitude: 40.7127753, Longitude: -74.0059728}, UTM{18, 'T', 583961.701, 4507348.282}, "18T WL 83961 07348"},
+		{Position{Latitude: -33.8567844, Longitude: 151.2152967}, UTM{56, 'H', 334900.234, 6252290.478}, "56H LH 34900 52290"},
+		{Position{Latitude: 0, Longitude: 3}, UTM{31, 'N', 500000, 0}, "31N EA 00000 00000"},
+		// Norway and Svalbard exceptions
+		{Position{Latitude: 60.5, Longitude: 5.5}, UTM{32, 'V', 307793.019, 6712209.068}, "32V LN 07793 12209"},
+		{Position{Latitude: 83.9, Longitude: 20}, UTM{33, 'X', 559245.722, 9319502.269}, "33X WP 59245 19502"},
+	} {
+		u, err := test.position.UTM()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if u.Zone != test.expected.Zone || u.Band != test.expected.Band || math.Abs(u.Easting-test.expected.Easting) > 0.01 || math.Abs(u.Northing-test.expected.Northing) > 0.01 {
+			t.Fatalf("Wrong UTM coordinates of %+v (got: %+v, wanted: %+v)", test.position, u, test.expected)
+		}
+
+		back, err := u.Position()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if math.Abs(float64(back.Latitude-test.position.Latitude)) > 1e-9 || math.Abs(float64(back.Longitude-test.position.Longitude)) > 1e-9 {
+			t.Fatalf("Wrong UTM round-trip (got: %+v, wanted: %+v)", back, test.position)
+		}
+
+		// MGRS
+		if m := u.MGRS().String(); m != test.mgrs {
+			t.Fatalf("Wrong MGRS reference (got: %s, wanted: %s)", m, test.mgrs)
+		}
+
+		m, err := ParseMGRS(test.mgrs)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", test.mgrs, err.Error())
+		}
+		fromMGRS, err := m.UTM()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if fromMGRS.Zone != u.Zone || fromMGRS.Band != u.Band || fromMGRS.Easting != math.Floor(u.Easting) || fromMGRS.Northing != math.Floor(u.Northing) {
+			t.Fatalf("Wrong UTM coordinates of MGRS reference \"%s\" (got: %+v, wanted: %s)", test.mgrs, fromMGRS, u)
+		}
+	}
+
+	// Published example of the UTM coordinate system (CN Tower, Toronto: 43°38′33.24″N 79°23′13.7″W)
+	cnTower := Position{Latitude: Latitude(43 + 38.0/60 + 33.24/3600), Longitude: Longitude(-(79 + 23.0/60 + 13.7/3600))}
+	if u, err := cnTower.UTM(); err != nil || u.String() != "17T 630084 4833438" {
+		t.Fatalf("Wrong UTM coordinates of CN Tower (got: %s, err: %v)", u, err)
+	}
+
+	if _, err := (Position{Latitude: 84.5}).UTM(); err == nil {
+		t.Fatal("Latitude above 84°N should be rejected")
+	}
+}
+
+func TestMGRSFormat(t *testing.T) {
+	m, err := ParseMGRS("31UDQ4825011951")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := m.Format(3); s != "31U DQ 482 119" {
+		t.Fatalf("Wrong MGRS format (got: %s)", s)
+	}
+	if s := m.Format(0); s != "31U DQ" {
+		t.Fatalf("Wrong MGRS format (got: %s)", s)
+	}
+
+	// Reduced precision is the south-west corner of the square
+	if m, err = ParseMGRS("31U DQ 482 119"); err != nil || m.Easting != 48200 || m.Northing != 11900 {
+		t.Fatalf("Wrong MGRS reference (got: %+v, err: %v)", m, err)
+	}
+
+	for _, invalid := range []string{"31U DQ 4825 119", "61U DQ 48250 11951", "31I DQ 48250 11951", "31U JQ 48250 11951"} {
+		m, err := ParseMGRS(invalid)
+		if err == nil {
+			_, err = m.Position()
+		}
+		if err == nil {
+			t.Fatalf("MGRS reference \"%s\" should be rejected", invalid)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package nmea
+
+import "fmt"
+
+// Examples:
+// $VWVBW,5.2,-0.1,A,5.4,0.2,A*6A
+// $VWVBW,5.2,-0.1,A,,,V,0.3,A,,V*44
+
+func NewVBW(m Message) *VBW {
+	return &VBW{Message: m}
+}
+
+// VBW is the dual ground/water speed, each speed is in knots (transverse speed is negative to port) and empty if not available
+type VBW struct {
+	Message
+
+	LongitudinalWaterSpeed  *float64
+	TransverseWaterSpeed    *float64
+	IsWaterSpeedValid       DataValid
+	LongitudinalGroundSpeed *float64
+	TransverseGroundSpeed   *float64
+	IsGroundSpeedValid      DataValid
+
+	// NMEA 3.0 and later
+	SternTransverseWaterSpeed  *float64
+	IsSternWaterSpeedValid     DataValid
+	SternTransverseGroundSpeed *float64
+	IsSternGroundSpeedValid    DataValid
+}
+
+func (m *VBW) parse() (err error) {
+	if len(m.Fields) != 6 && len(m.Fields) != 10 {
+		return m.Error(fmt.Errorf("Incomplete VBW message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 6, 10))
+	}
+
+	for i, v := range map[int]**float64{
+		0: &m.LongitudinalWaterSpeed,
+		1: &m.TransverseWaterSpeed,
+		3: &m.LongitudinalGroundSpeed,
+		4: &m.TransverseGroundSpeed,
+	} {
+		if *v, err = parseOptionalFloat(m.Fields[i]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse speed from data field at %d (got: %s)", i+1, m.Fields[i]))
+		}
+	}
+
+	m.IsWaterSpeedValid = (m.Fields[2] == "A")
+	m.IsGroundSpeedValid = (m.Fields[5] == "A")
+
+	if len(m.Fields) == 10 {
+		if m.SternTransverseWaterSpeed, err = parseOptionalFloat(m.Fields[6]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse speed from data field at %d (got: %s)", 7, m.Fields[6]))
+		}
+
+		if m.SternTransverseGroundSpeed, err = parseOptionalFloat(m.Fields[8]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse speed from data field at %d (got: %s)", 9, m.Fields[8]))
+		}
+
+		m.IsSternWaterSpeedValid = (m.Fields[7] == "A")
+		m.IsSternGroundSpeedValid = (m.Fields[9] == "A")
+	}
+
+	return nil
+}
+
+func (m VBW) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("VBW")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		formatOptionalFloat(m.LongitudinalWaterSpeed, m.field(0), "%.1f"),
+		formatOptionalFloat(m.TransverseWaterSpeed, m.field(1), "%.1f"),
+		m.IsWaterSpeedValid.Serialize(),
+		formatOptionalFloat(m.LongitudinalGroundSpeed, m.field(3), "%.1f"),
+		formatOptionalFloat(m.TransverseGroundSpeed, m.field(4), "%.1f"),
+		m.IsGroundSpeedValid.Serialize(),
+	)
+
+	// Stern speeds only since NMEA 3.0, kept when parsed even if empty
+	if m.SternTransverseWaterSpeed != nil || m.SternTransverseGroundSpeed != nil || len(m.Fields) == 10 {
+		fields = append(fields,
+			formatOptionalFloat(m.SternTransverseWaterSpeed, m.field(6), "%.1f"),
+			m.IsSternWaterSpeedValid.Serialize(),
+			formatOptionalFloat(m.SternTransverseGroundSpeed, m.field(8), "%.1f"),
+			m.IsSternGroundSpeedValid.Serialize(),
+		)
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
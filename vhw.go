@@ -0,0 +1,84 @@
+package nmea
+
+import "fmt"
+
+// Examples:
+// $VWVHW,245.1,T,245.1,M,5.2,N,9.6,K*5C
+// $VWVHW,,T,,M,0.0,N,0.0,K*54
+
+func NewVHW(m Message) *VHW {
+	return &VHW{Message: m}
+}
+
+// VHW is the compass heading and speed of the vessel relative to the water
+type VHW struct {
+	Message
+
+	HeadingTrue     *float64 // Heading (true) in degree, empty if not available
+	HeadingMagnetic *float64 // Heading (magnetic) in degree, empty if not available
+	SpeedKnots      *float64 // Speed through water in knots, empty if not available
+	SpeedKmh        *float64 // Speed through water in km/h, empty if not available
+}
+
+func (m *VHW) parse() (err error) {
+	if len(m.Fields) != 8 {
+		return m.Error(fmt.Errorf("Incomplete VHW message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 8))
+	}
+
+	// Validate fixed field
+	for i, v := range map[int]string{1: "T", 3: "M", 5: "N", 7: "K"} {
+		if m.Fields[i] != v {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], v))
+		}
+	}
+
+	if m.HeadingTrue, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse true heading from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.HeadingMagnetic, err = parseOptionalFloat(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse magnetic heading from data field (got: %s)", m.Fields[2]))
+	}
+
+	if m.SpeedKnots, err = parseOptionalFloat(m.Fields[4]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse speed from data field (got: %s)", m.Fields[4]))
+	}
+
+	if m.SpeedKmh, err = parseOptionalFloat(m.Fields[6]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse speed from data field (got: %s)", m.Fields[6]))
+	}
+
+	return nil
+}
+
+func (m VHW) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("VHW")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		formatOptionalFloat(m.HeadingTrue, m.field(0), "%.1f"), "T",
+		formatOptionalFloat(m.HeadingMagnetic, m.field(2), "%.1f"), "M",
+		formatOptionalFloat(m.SpeedKnots, m.field(4), "%.1f"), "N",
+		formatOptionalFloat(m.SpeedKmh, m.field(6), "%.1f"), "K",
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// Speed return speed through water in knots from the first available unit (same unit as GPVTG.SpeedKnots)
+func (m VHW) Speed() *float64 {
+	var v float64
+	switch {
+	case m.SpeedKnots != nil:
+		return m.SpeedKnots
+	case m.SpeedKmh != nil:
+		v = SpeedUnitKmh.ToKnots(*m.SpeedKmh)
+	default:
+		return nil
+	}
+	return &v
+}
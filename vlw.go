@@ -0,0 +1,78 @@
+package nmea
+
+import "fmt"
+
+// Examples:
+// $VWVLW,1254.7,N,12.3,N*49
+// $VWVLW,1254.7,N,12.3,N,1302.1,N,12.9,N*42
+
+func NewVLW(m Message) *VLW {
+	return &VLW{Message: m}
+}
+
+// VLW is the distance traveled through the water (and over the ground since NMEA 3.0)
+type VLW struct {
+	Message
+
+	TotalWaterDistance  *float64 // Total cumulative water distance in nautical miles, empty if not available
+	TripWaterDistance   *float64 // Water distance since reset in nautical miles, empty if not available
+	TotalGroundDistance *float64 // Total cumulative ground distance in nautical miles, empty if not available
+	TripGroundDistance  *float64 // Ground distance since reset in nautical miles, empty if not available
+}
+
+func (m *VLW) parse() (err error) {
+	if len(m.Fields) != 4 && len(m.Fields) != 8 {
+		return m.Error(fmt.Errorf("Incomplete VLW message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 4, 8))
+	}
+
+	// Validate fixed field
+	for i := 1; i < len(m.Fields); i += 2 {
+		if m.Fields[i] != "N" {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], "N"))
+		}
+	}
+
+	if m.TotalWaterDistance, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse total water distance from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.TripWaterDistance, err = parseOptionalFloat(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse trip water distance from data field (got: %s)", m.Fields[2]))
+	}
+
+	if len(m.Fields) == 8 {
+		if m.TotalGroundDistance, err = parseOptionalFloat(m.Fields[4]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse total ground distance from data field (got: %s)", m.Fields[4]))
+		}
+
+		if m.TripGroundDistance, err = parseOptionalFloat(m.Fields[6]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse trip ground distance from data field (got: %s)", m.Fields[6]))
+		}
+	}
+
+	return nil
+}
+
+func (m VLW) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("VLW")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		formatOptionalFloat(m.TotalWaterDistance, m.field(0), "%.1f"), "N",
+		formatOptionalFloat(m.TripWaterDistance, m.field(2), "%.1f"), "N",
+	)
+
+	// Ground distances only since NMEA 3.0, kept when parsed even if empty
+	if m.TotalGroundDistance != nil || m.TripGroundDistance != nil || len(m.Fields) == 8 {
+		fields = append(fields,
+			formatOptionalFloat(m.TotalGroundDistance, m.field(4), "%.1f"), "N",
+			formatOptionalFloat(m.TripGroundDistance, m.field(6), "%.1f"), "N",
+		)
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
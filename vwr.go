@@ -0,0 +1,110 @@
+package nmea
+
+import (
+	"fmt"
+	"math"
+)
+
+// Examples:
+// $IIVWR,045.0,L,12.6,N,6.5,M,23.3,K*52
+
+func NewVWR(m Message) *VWR {
+	return &VWR{Message: m}
+}
+
+// VWR is the legacy relative (apparent) wind speed and angle sentence, replaced by MWV
+type VWR struct {
+	Message
+
+	Angle      *float64       // Wind angle relative to the bow in degree (0 ~ 180), Right (starboard) is positive, Left (port) is negative
+	Side       SteerDirection // Side of the wind angle as output (Left is port, Right is starboard), empty if not available
+	SpeedKnots *float64       // Wind speed in knots, empty if not available
+	SpeedMs    *float64       // Wind speed in m/s, empty if not available
+	SpeedKmh   *float64       // Wind speed in km/h, empty if not available
+}
+
+func (m *VWR) parse() (err error) {
+	if len(m.Fields) != 8 {
+		return m.Error(fmt.Errorf("Incomplete VWR message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 8))
+	}
+
+	// Validate fixed field
+	for i, v := range map[int]string{3: "N", 5: "M", 7: "K"} {
+		if m.Fields[i] != v {
+			return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", i+1, m.Fields[i], v))
+		}
+	}
+
+	if m.Angle, err = parseOptionalFloat(m.Fields[0]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse wind angle from data field (got: %s)", m.Fields[0]))
+	}
+
+	if m.Angle != nil {
+		if m.Side, err = ParseSteerDirection(m.Fields[1]); err != nil {
+			return m.Error(fmt.Errorf("Wrong wind direction (got: %s)", m.Fields[1]))
+		}
+		if m.Side == SteerLeft {
+			*m.Angle = 0 - *m.Angle
+		}
+	}
+
+	if m.SpeedKnots, err = parseOptionalFloat(m.Fields[2]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse wind speed from data field (got: %s)", m.Fields[2]))
+	}
+
+	if m.SpeedMs, err = parseOptionalFloat(m.Fields[4]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse wind speed from data field (got: %s)", m.Fields[4]))
+	}
+
+	if m.SpeedKmh, err = parseOptionalFloat(m.Fields[6]); err != nil {
+		return m.Error(fmt.Errorf("Unable to parse wind speed from data field (got: %s)", m.Fields[6]))
+	}
+
+	return nil
+}
+
+func (m VWR) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("VWR")
+	fields := make([]string, 0)
+
+	if m.Angle == nil {
+		fields = append(fields, "", "")
+	} else {
+		// Keep side as output (ie: "0.0,L"), otherwise derive it from sign
+		side := m.Side
+		if len(side) == 0 {
+			if side = SteerRight; *m.Angle < 0 {
+				side = SteerLeft
+			}
+		}
+		fields = append(fields, formatFloat(math.Abs(*m.Angle), m.field(0), "%.1f"), side.Serialize())
+	}
+
+	fields = append(fields,
+		formatOptionalFloat(m.SpeedKnots, m.field(2), "%.1f"), "N",
+		formatOptionalFloat(m.SpeedMs, m.field(4), "%.1f"), "M",
+		formatOptionalFloat(m.SpeedKmh, m.field(6), "%.1f"), "K",
+	)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// Speed return wind speed in m/s from the first available unit
+func (m VWR) Speed() *float64 {
+	var v float64
+	switch {
+	case m.SpeedMs != nil:
+		return m.SpeedMs
+	case m.SpeedKnots != nil:
+		v = SpeedUnitKnots.ToMetersPerSecond(*m.SpeedKnots)
+	case m.SpeedKmh != nil:
+		v = SpeedUnitKmh.ToMetersPerSecond(*m.SpeedKmh)
+	default:
+		return nil
+	}
+	return &v
+}
@@ -0,0 +1,327 @@
+package nmea
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// WMMReferenceRadius is the geomagnetic reference radius in meter of the World Magnetic Model
+	WMMReferenceRadius = 6371200.0
+	// WMMLifespan is the validity period in year of a World Magnetic Model from its epoch
+	WMMLifespan = 5
+	// DefaultMagneticModelFile is the embedded WMM2025 coefficients file (WMM.COF) of NOAA/NCEI (see data/README.md)
+	DefaultMagneticModelFile = "data/WMM.COF"
+)
+
+// DefaultMagneticModel is the magnetic model used by RMC, HDG and VTG helpers when none is provided,
+// loaded from embedded DefaultMagneticModelFile (nil when the coefficients aren't bundled)
+var DefaultMagneticModel = mustLoadMagneticModel(embedded, DefaultMagneticModelFile)
+
+// MagneticModel is a spherical harmonic model of the main geomagnetic field (ie: World Magnetic Model)
+type MagneticModel struct {
+	Name       string
+	Epoch      float64     // Decimal year of reference of coefficients
+	Degree     int         // Maximum degree and order of coefficients
+	G, H       [][]float64 // Schmidt semi-normalized Gauss coefficients in nT indexed by degree and order
+	GDot, HDot [][]float64 // Secular variation of Gauss coefficients in nT/year
+}
+
+// MagneticField is the geomagnetic field computed by a magnetic model
+type MagneticField struct {
+	North, East, Down float64 // Field components in nT (X, Y, Z)
+	Horizontal        float64 // Horizontal intensity in nT (H)
+	Intensity         float64 // Total intensity in nT (F)
+	Declination       float64 // Magnetic declination (variation) in degree, East is positive, West is negative (D)
+	Inclination       float64 // Magnetic inclination (dip) in degree, positive downward (I)
+}
+
+// ParseMagneticModel read a magnetic model in NOAA coefficients format as a header with epoch and name followed by
+// "n m g h gDot hDot" lines, until end of data or a line of 9 (ie: WMM.COF of World Magnetic Model from NOAA/NCEI)
+func ParseMagneticModel(r io.Reader) (*MagneticModel, error) {
+	scanner := bufio.NewScanner(r)
+
+	mm := &MagneticModel{}
+	type coefficient struct {
+		n, m             int
+		g, h, gDot, hDot float64
+	}
+	coefficients := make([]coefficient, 0)
+
+	header := true
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if strings.HasPrefix(fields[0], "9999") {
+			break
+		}
+
+		if header {
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("Incomplete magnetic model header, not enougth values (got: %d, wanted: %d)", len(fields), 2)
+			}
+			epoch, err := strconv.ParseFloat(fields[0], 64)
+			if err != nil {
+				return nil, fmt.Errorf("Unable to parse magnetic model epoch (got: %s)", fields[0])
+			}
+			mm.Epoch, mm.Name, header = epoch, fields[1], false
+			continue
+		}
+
+		if len(fields) != 6 {
+			return nil, fmt.Errorf("Incomplete magnetic model coefficient, not enougth values (got: %d, wanted: %d)", len(fields), 6)
+		}
+
+		var (
+			c   coefficient
+			err error
+		)
+		if c.n, err = strconv.Atoi(fields[0]); err != nil || c.n < 1 {
+			return nil, fmt.Errorf("Unable to parse magnetic model degree (got: %s)", fields[0])
+		}
+		if c.m, err = strconv.Atoi(fields[1]); err != nil || c.m < 0 || c.m > c.n {
+			return nil, fmt.Errorf("Unable to parse magnetic model order (got: %s)", fields[1])
+		}
+		values := make([]float64, 4)
+		for i := range values {
+			if values[i], err = strconv.ParseFloat(fields[2+i], 64); err != nil {
+				return nil, fmt.Errorf("Unable to parse magnetic model coefficient (got: %s)", fields[2+i])
+			}
+		}
+		c.g, c.h, c.gDot, c.hDot = values[0], values[1], values[2], values[3]
+
+		if c.n > mm.Degree {
+			mm.Degree = c.n
+		}
+		coefficients = append(coefficients, c)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	if header || len(coefficients) == 0 {
+		return nil, fmt.Errorf("Magnetic model without coefficients")
+	}
+
+	mm.G, mm.H = newTriangle(mm.Degree), newTriangle(mm.Degree)
+	mm.GDot, mm.HDot = newTriangle(mm.Degree), newTriangle(mm.Degree)
+	for _, c := range coefficients {
+		mm.G[c.n][c.m], mm.H[c.n][c.m] = c.g, c.h
+		mm.GDot[c.n][c.m], mm.HDot[c.n][c.m] = c.gDot, c.hDot
+	}
+	return mm, nil
+}
+
+// LoadMagneticModel read a magnetic model in NOAA coefficients format from file system (see ParseMagneticModel)
+func LoadMagneticModel(fsys fs.FS, name string) (*MagneticModel, error) {
+	f, err := fsys.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseMagneticModel(f)
+}
+
+// mustLoadMagneticModel return embedded magnetic model, nil if not bundled and panic if invalid
+func mustLoadMagneticModel(fsys fs.FS, name string) *MagneticModel {
+	mm, err := LoadMagneticModel(fsys, name)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		return nil
+	case err != nil:
+		panic(fmt.Sprintf("Invalid embedded magnetic model %s: %s", name, err.Error()))
+	}
+	return mm
+}
+
+// magneticModel return model or DefaultMagneticModel if nil, error when none is available
+func magneticModel(model *MagneticModel) (*MagneticModel, error) {
+	if model == nil {
+		model = DefaultMagneticModel
+	}
+	if model == nil {
+		return nil, fmt.Errorf("Magnetic model not available")
+	}
+	return model, nil
+}
+
+// newTriangle return a lower triangular matrix indexed by degree and order
+func newTriangle(degree int) [][]float64 {
+	t := make([][]float64, degree+1)
+	for n := range t {
+		t[n] = make([]float64, n+1)
+	}
+	return t
+}
+
+// decimalYear return date as decimal year (ie: 2022.5)
+func decimalYear(t time.Time) float64 {
+	t = t.UTC()
+	start := time.Date(t.Year(), time.January, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(1, 0, 0)
+	return float64(t.Year()) + float64(t.Sub(start))/float64(end.Sub(start))
+}
+
+// IsValid return true when date is within the validity period of the model (see WMMLifespan)
+func (mm *MagneticModel) IsValid(t time.Time) bool {
+	year := decimalYear(t)
+	return year >= mm.Epoch && year < mm.Epoch+WMMLifespan
+}
+
+// Field return geomagnetic field at position, altitude in meter above WGS84 ellipsoid and date
+// (declination is meaningless close to geographic poles)
+func (mm *MagneticModel) Field(p Position, altitude float64, t time.Time) MagneticField {
+	dt := decimalYear(t) - mm.Epoch
+
+	// Geocentric spherical coordinates
+	lat, lon := p.radians()
+	e := p.ECEF(altitude)
+	r := math.Sqrt(e.X*e.X + e.Y*e.Y + e.Z*e.Z)
+	geocentricLat := math.Asin(e.Z / r)
+
+	// Associated Legendre functions of cos(colatitude) and their derivatives along colatitude
+	cosTheta, sinTheta := math.Sin(geocentricLat), math.Max(math.Cos(geocentricLat), 1e-12)
+	pnm, dpnm := newTriangle(mm.Degree), newTriangle(mm.Degree)
+	pnm[0][0] = 1
+	for n := 1; n <= mm.Degree; n++ {
+		for m := 0; m <= n; m++ {
+			if m == n {
+				pnm[n][m] = sinTheta * pnm[n-1][m-1]
+				dpnm[n][m] = sinTheta*dpnm[n-1][m-1] + cosTheta*pnm[n-1][m-1]
+				continue
+			}
+			pnm[n][m] = cosTheta * pnm[n-1][m]
+			dpnm[n][m] = cosTheta*dpnm[n-1][m] - sinTheta*pnm[n-1][m]
+			if n > 1 && m <= n-2 {
+				k := float64((n-1)*(n-1)-m*m) / float64((2*n-1)*(2*n-3))
+				pnm[n][m] -= k * pnm[n-2][m]
+				dpnm[n][m] -= k * dpnm[n-2][m]
+			}
+		}
+	}
+
+	// Sum field components in geocentric frame with Schmidt semi-normalization factors
+	var x, y, z float64
+	schmidt := 1.0
+	for n := 1; n <= mm.Degree; n++ {
+		schmidt *= float64(2*n-1) / float64(n)
+		ratio := math.Pow(WMMReferenceRadius/r, float64(n+2))
+
+		s := schmidt
+		for m := 0; m <= n; m++ {
+			if m > 0 {
+				factor := 1.0
+				if m == 1 {
+					factor = 2
+				}
+				s *= math.Sqrt(float64(n-m+1) * factor / float64(n+m))
+			}
+
+			g, h := mm.G[n][m]+dt*mm.GDot[n][m], mm.H[n][m]+dt*mm.HDot[n][m]
+			sinM, cosM := math.Sincos(float64(m) * lon)
+			t1 := g*cosM + h*sinM
+
+			x += ratio * t1 * s * dpnm[n][m]
+			y += ratio * float64(m) * (g*sinM - h*cosM) * s * pnm[n][m]
+			z -= ratio * float64(n+1) * t1 * s * pnm[n][m]
+		}
+	}
+	y /= sinTheta
+
+	// Rotate to geodetic frame
+	sinPsi, cosPsi := math.Sincos(geocentricLat - lat)
+	f := MagneticField{North: x*cosPsi - z*sinPsi, East: y, Down: x*sinPsi + z*cosPsi}
+	f.Horizontal = math.Hypot(f.North, f.East)
+	f.Intensity = math.Hypot(f.Horizontal, f.Down)
+	f.Declination = math.Atan2(f.East, f.North) * 180 / math.Pi
+	f.Inclination = math.Atan2(f.Down, f.Horizontal) * 180 / math.Pi
+	return f
+}
+
+// Declination return magnetic declination in degree (East is positive, West is negative) at position,
+// altitude in meter above WGS84 ellipsoid and date
+func (mm *MagneticModel) Declination(p Position, altitude float64, t time.Time) float64 {
+	return mm.Field(p, altitude, t).Declination
+}
+
+// TrueToMagnetic return magnetic course in degree (0 to 360) from true course and magnetic variation (East is positive)
+func TrueToMagnetic(course, variation float64) float64 {
+	return normalizeDegrees(course - variation)
+}
+
+// MagneticToTrue return true course in degree (0 to 360) from magnetic course and magnetic variation (East is positive)
+func MagneticToTrue(course, variation float64) float64 {
+	return normalizeDegrees(course + variation)
+}
+
+// ModelMagneticVariation return magnetic variation computed by magnetic model (DefaultMagneticModel if nil)
+// at sea level for position and date of the fix
+func (m GPRMC) ModelMagneticVariation(model *MagneticModel) (float64, error) {
+	model, err := magneticModel(model)
+	if err != nil {
+		return 0, err
+	}
+	return model.Declination(m.Position(), 0, m.DateTimeUTC), nil
+}
+
+// FillMagneticVariation set magnetic variation from magnetic model (DefaultMagneticModel if nil) when not output
+// by the receiver, return true if filled (false when no model is available, date of the fix is unknown
+// or outside of the validity period of the model)
+func (m *GPRMC) FillMagneticVariation(model *MagneticModel) bool {
+	model, err := magneticModel(model)
+	if err != nil || m.hasMagneticVariation() || !model.IsValid(m.DateTimeUTC) {
+		return false
+	}
+
+	m.MagneticVariation, _ = m.ModelMagneticVariation(model)
+	return true
+}
+
+// CheckMagneticVariation return error when magnetic variation output by the receiver differs from magnetic model
+// (DefaultMagneticModel if nil) by more than tolerance in degree or when date of the fix is outside of the validity
+// period of the model, nil if not output
+func (m GPRMC) CheckMagneticVariation(model *MagneticModel, tolerance float64) error {
+	model, err := magneticModel(model)
+	if err != nil {
+		return err
+	}
+	if !model.IsValid(m.DateTimeUTC) {
+		return fmt.Errorf("Magnetic model %s not valid at date of the fix (got: %s)", model.Name, m.DateTimeUTC.Format("2006-01-02"))
+	}
+	if !m.hasMagneticVariation() {
+		return nil
+	}
+
+	expected, _ := m.ModelMagneticVariation(model)
+	if diff := math.Abs(normalizeDegrees(m.MagneticVariation-expected+180) - 180); diff > tolerance {
+		return fmt.Errorf("Magnetic variation mismatch (got: %.1f, expected: %.1f)", m.MagneticVariation, expected)
+	}
+	return nil
+}
+
+// MagneticCourse return magnetic course over ground in degree from magnetic variation (East is positive)
+func (m GPVTG) MagneticCourse(variation float64) float64 {
+	return TrueToMagnetic(m.COG, variation)
+}
+
+// FillVariation set magnetic variation from magnetic model (DefaultMagneticModel if nil) at position, altitude
+// in meter above WGS84 ellipsoid and date when unknown, return true if filled (false when no model is available
+// or date is outside of the validity period of the model)
+func (m *HDG) FillVariation(model *MagneticModel, p Position, altitude float64, t time.Time) bool {
+	model, err := magneticModel(model)
+	if err != nil || m.Variation != nil || !model.IsValid(t) {
+		return false
+	}
+	v := model.Declination(p, altitude, t)
+	m.Variation = &v
+	return true
+}
@@ -0,0 +1,344 @@
+package nmea
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+)
+
+func TestMagneticModel(t *testing.T) {
+	// Synthetic tilted dipole with secular variation of axial term (field is analytic at equator)
+	raw := `
+    2020.0            TEST-DIPOLE     01/01/2020
+  1  0  -30000.0       0.0       20.0        0.0
+  1  1   -1500.0    4500.0        0.0        0.0
+999999999999999999999999999999999999999999999999
+999999999999999999999999999999999999999999999999
+`
+	model, err := ParseMagneticModel(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if model.Name != "TEST-DIPOLE" || model.Epoch != 2020 || model.Degree != 1 {
+		t.Fatalf("Wrong magnetic model header (got: %s, %f, %d)", model.Name, model.Epoch, model.Degree)
+	}
+
+	for _, date := range []time.Time{
+		time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2022, time.July, 2, 12, 0, 0, 0, time.UTC),
+	} {
+		for _, lon := range []float64{0, 90, -135} {
+			for _, altitude := range []float64{0, 100000} {
+				f := model.Field(Position{Latitude: 0, Longitude: Longitude(lon)}, altitude, date)
+
+				k := math.Pow(WMMReferenceRadius/(WGS84SemiMajorAxis+altitude), 3)
+				g10 := -30000 + 20*(decimalYear(date)-2020)
+				sinLon, cosLon := math.Sincos(lon * math.Pi / 180)
+				x, y, z := -g10*k, k*(-1500*sinLon-4500*cosLon), -2*k*(-1500*cosLon+4500*sinLon)
+
+				for _, c := range []struct {
+					name          string
+					got, expected float64
+				}{
+					{"north", f.North, x},
+					{"east", f.East, y},
+					{"down", f.Down, z},
+					{"intensity", f.Intensity, math.Sqrt(x*x + y*y + z*z)},
+					{"declination", f.Declination, math.Atan2(y, x) * 180 / math.Pi},
+					{"inclination", f.Inclination, math.Atan2(z, math.Hypot(x, y)) * 180 / math.Pi},
+				} {
+					if math.Abs(c.got-c.expected) > 1e-6 {
+						t.Fatalf("Wrong %s at %s, longitude %f, altitude %f (got: %f, wanted: %f)", c.name, date, lon, altitude, c.got, c.expected)
+					}
+				}
+			}
+		}
+	}
+
+	for date, valid := range map[time.Time]bool{
+		time.Date(2019, time.December, 31, 0, 0, 0, 0, time.UTC): false,
+		time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC):   true,
+		time.Date(2024, time.December, 31, 0, 0, 0, 0, time.UTC): true,
+		time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC):   false,
+	} {
+		if model.IsValid(date) != valid {
+			t.Fatalf("Wrong validity of magnetic model at %s (wanted: %t)", date, valid)
+		}
+	}
+
+	for _, invalid := range []string{
+		"",
+		"2020.0 WMM-2020\n",
+		"2020.0 WMM-2020\n1 0 -29404.5 0.0 6.7\n",
+		"2020.0 WMM-2020\n1 2 -29404.5 0.0 6.7 0.0\n",
+		"2020.0 WMM-2020\n1 0 -29404.5 0.0 x 0.0\n",
+	} {
+		if _, err := ParseMagneticModel(strings.NewReader(invalid)); err == nil {
+			t.Fatalf("Magnetic model \"%s\" should be invalid", invalid)
+		}
+	}
+
+	// Model isn't used outside of its validity period or without date
+	for _, raw := range []string{
+		"$GPRMC,013732.000,A,3150.7238,N,11711.7278,E,0.00,0.00,220413,,,A*68",
+		"$GPRMC,013732.000,A,3150.7238,N,11711.7278,E,0.00,0.00,,,,A*6E",
+	} {
+		msg, err := Parse(raw)
+		if err != nil {
+			t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+		}
+		if rmc := msg.(*GPRMC); rmc.FillMagneticVariation(model) || rmc.CheckMagneticVariation(model, 0.1) == nil {
+			t.Fatalf("Magnetic variation of \"%s\" shouldn't be filled or checked", raw)
+		}
+	}
+
+	// Fill magnetic variation not output by receiver
+	rawRMC := "$GPRMC,013732.000,A,3150.7238,N,11711.7278,E,0.00,0.00,220422,,,A*6A"
+	msg, err := Parse(rawRMC)
+	if err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", rawRMC, err.Error())
+	}
+	rmc := msg.(*GPRMC)
+
+	if err := rmc.CheckMagneticVariation(model, 0.1); err != nil {
+		t.Fatalf("Magnetic variation not output shouldn't be checked, err: %s", err.Error())
+	}
+
+	expected := model.Declination(rmc.Position(), 0, rmc.DateTimeUTC)
+	if !rmc.FillMagneticVariation(model) || rmc.MagneticVariation != expected {
+		t.Fatalf("Wrong filled magnetic variation (got: %f, wanted: %f)", rmc.MagneticVariation, expected)
+	}
+	if rmc.FillMagneticVariation(model) {
+		t.Fatal("Magnetic variation shouldn't be filled twice")
+	}
+	if rmc.Fields[9] != "" || rmc.Fields[10] != "" {
+		t.Fatalf("Parsed data fields shouldn't be modified (got: %s, %s)", rmc.Fields[9], rmc.Fields[10])
+	}
+
+	filled, err := Parse(rmc.Serialize())
+	if err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", rmc.Serialize(), err.Error())
+	}
+	if v := filled.(*GPRMC).MagneticVariation; math.Abs(v-expected) > 0.05 {
+		t.Fatalf("Wrong serialized magnetic variation (got: %f, wanted: %f)", v, expected)
+	}
+	if err := filled.(*GPRMC).CheckMagneticVariation(model, 0.1); err != nil {
+		t.Fatalf("Filled magnetic variation should match, err: %s", err.Error())
+	}
+
+	rmc.MagneticVariation += 2
+	if err := rmc.CheckMagneticVariation(model, 1); err == nil {
+		t.Fatal("Magnetic variation mismatch should be reported")
+	}
+
+	// Default magnetic model when none is provided
+	defaultModel := DefaultMagneticModel
+	DefaultMagneticModel = nil
+	if _, err := rmc.ModelMagneticVariation(nil); err == nil {
+		t.Fatal("Magnetic variation shouldn't be computed without magnetic model")
+	}
+	DefaultMagneticModel = model
+	v, err := rmc.ModelMagneticVariation(nil)
+	DefaultMagneticModel = defaultModel
+	if err != nil || v != expected {
+		t.Fatalf("Wrong magnetic variation with default model (got: %f, wanted: %f, err: %v)", v, expected, err)
+	}
+
+	// True and magnetic courses
+	if v := TrueToMagnetic(10, -12.6); math.Abs(v-22.6) > 1e-9 {
+		t.Fatalf("Wrong magnetic course (got: %f, wanted: %f)", v, 22.6)
+	}
+	if v := MagneticToTrue(5, -12.6); math.Abs(v-352.4) > 1e-9 {
+		t.Fatalf("Wrong true course (got: %f, wanted: %f)", v, 352.4)
+	}
+
+	vtg := GPVTG{COG: 355}
+	if v := vtg.MagneticCourse(-10); math.Abs(v-5) > 1e-9 {
+		t.Fatalf("Wrong magnetic course over ground (got: %f, wanted: %f)", v, 5.0)
+	}
+
+	heading := 90.0
+	hdg := HDG{Heading: &heading}
+	if !hdg.FillVariation(model, rmc.Position(), 0, rmc.DateTimeUTC) || *hdg.Variation != expected {
+		t.Fatalf("Wrong filled magnetic variation (got: %v, wanted: %f)", hdg.Variation, expected)
+	}
+	if h := hdg.TrueHeading(); h == nil || math.Abs(*h-MagneticToTrue(90, expected)) > 1e-9 {
+		t.Fatalf("Wrong true heading (got: %v)", h)
+	}
+}
+
+func TestLoadMagneticModel(t *testing.T) {
+	fsys := fstest.MapFS{"WMM.COF": {Data: []byte("2020.0 TEST-DIPOLE 01/01/2020\n1 0 -30000.0 0.0 20.0 0.0\n")}}
+
+	model, err := LoadMagneticModel(fsys, "WMM.COF")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if model.Name != "TEST-DIPOLE" || model.G[1][0] != -30000 {
+		t.Fatalf("Wrong magnetic model (got: %s, %f)", model.Name, model.G[1][0])
+	}
+
+	// Missing coefficients aren't bundled
+	if model := mustLoadMagneticModel(fsys, "missing.COF"); model != nil {
+		t.Fatal("Missing magnetic model should be nil")
+	}
+}
+
+func TestDefaultMagneticModel(t *testing.T) {
+	if DefaultMagneticModel == nil {
+		t.Skipf("WMM2025 coefficients %s not bundled (see data/README.md)", DefaultMagneticModelFile)
+	}
+
+	// Test values published by NOAA/NCEI with the model, one point per line as:
+	// decimal year, height above WGS84 ellipsoid (km), latitude, longitude, declination, inclination, H, X, Y, Z, F
+	raw, err := os.ReadFile(filepath.Join("testdata", "WMM2025_TestValues.txt"))
+	if err != nil {
+		t.Skipf("WMM2025 test values not available, err: %s", err.Error())
+	}
+
+	for _, line := range strings.Split(string(raw), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if len(fields) < 11 {
+			t.Fatalf("Incomplete test values (got: %s)", line)
+		}
+
+		values := make([]float64, 11)
+		for i := range values {
+			if values[i], err = strconv.ParseFloat(fields[i], 64); err != nil {
+				t.Fatalf("Unable to parse test value \"%s\", err: %s", fields[i], err.Error())
+			}
+		}
+
+		year := int(values[0])
+		start := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+		date := start.Add(time.Duration((values[0] - float64(year)) * float64(start.AddDate(1, 0, 0).Sub(start))))
+
+		f := DefaultMagneticModel.Field(Position{Latitude: Latitude(values[2]), Longitude: Longitude(values[3])}, values[1]*1000, date)
+		for _, c := range []struct {
+			name               string
+			got, expected, tol float64
+		}{
+			{"declination", f.Declination, values[4], 0.01},
+			{"inclination", f.Inclination, values[5], 0.01},
+			{"horizontal intensity", f.Horizontal, values[6], 0.1},
+			{"north", f.North, values[7], 0.1},
+			{"east", f.East, values[8], 0.1},
+			{"down", f.Down, values[9], 0.1},
+			{"intensity", f.Intensity, values[10], 0.1},
+		} {
+			if math.Abs(c.got-c.expected) > c.tol {
+				t.Fatalf("Wrong %s for \"%s\" (got: %f, wanted: %f)", c.name, line, c.got, c.expected)
+			}
+		}
+	}
+}
+
+func TestMagneticModelHigherDegree(t *testing.T) {
+	// Synthetic model of degree 3 compared with the gradient of the magnetic potential computed in ECEF coordinates
+	// from unnormalized associated Legendre functions, then projected on local geodetic axes
+	raw := `
+    2020.0            TEST-DEGREE3    01/01/2020
+  1  0  -29000.0       0.0       10.0        0.0
+  1  1   -1500.0    4800.0        5.0      -20.0
+  2  0   -2500.0       0.0      -10.0        0.0
+  2  1    3000.0   -2900.0        2.0      -25.0
+  2  2    1700.0    -700.0        3.0      -30.0
+  3  0    1350.0       0.0        3.0        0.0
+  3  1   -2350.0     -70.0       -5.0        1.0
+  3  2    1200.0     250.0        1.0        2.0
+  3  3     580.0    -540.0       -7.0        4.0
+`
+	model, err := ParseMagneticModel(strings.NewReader(raw))
+	if err != nil {
+		t.Fatal(err)
+	}
+	date := time.Date(2023, time.March, 15, 0, 0, 0, 0, time.UTC)
+	dt := decimalYear(date) - model.Epoch
+
+	// Schmidt semi-normalized potential at ECEF position
+	potential := func(x, y, z float64) float64 {
+		r := math.Sqrt(x*x + y*y + z*z)
+		cosTheta, lon := z/r, math.Atan2(y, x)
+
+		var v float64
+		for n := 1; n <= model.Degree; n++ {
+			for m := 0; m <= n; m++ {
+				norm := math.Sqrt(2 * factorial(n-m) / factorial(n+m))
+				if m == 0 {
+					norm = 1
+				}
+				g, h := model.G[n][m]+dt*model.GDot[n][m], model.H[n][m]+dt*model.HDot[n][m]
+				sinM, cosM := math.Sincos(float64(m) * lon)
+				v += math.Pow(WMMReferenceRadius/r, float64(n+1)) * (g*cosM + h*sinM) * norm * legendre(n, m, cosTheta)
+			}
+		}
+		return WMMReferenceRadius * v
+	}
+
+	for _, p := range []Position{
+		{Latitude: 45, Longitude: 7},
+		{Latitude: -60.5, Longitude: -120.25},
+		{Latitude: 31.8, Longitude: 117.2},
+		{Latitude: 75, Longitude: -45},
+	} {
+		for _, altitude := range []float64{0, 12000} {
+			e := p.ECEF(altitude)
+
+			// Field is the negative gradient of the potential (central differences)
+			const step = 10.0
+			b := [3]float64{
+				-(potential(e.X+step, e.Y, e.Z) - potential(e.X-step, e.Y, e.Z)) / (2 * step),
+				-(potential(e.X, e.Y+step, e.Z) - potential(e.X, e.Y-step, e.Z)) / (2 * step),
+				-(potential(e.X, e.Y, e.Z+step) - potential(e.X, e.Y, e.Z-step)) / (2 * step),
+			}
+			east, north, up := ecefToENU(b[0], b[1], b[2], p)
+
+			f := model.Field(p, altitude, date)
+			for _, c := range []struct {
+				name          string
+				got, expected float64
+			}{
+				{"north", f.North, north},
+				{"east", f.East, east},
+				{"down", f.Down, -up},
+			} {
+				if math.Abs(c.got-c.expected) > 1e-3 {
+					t.Fatalf("Wrong %s component at %+v, altitude %f (got: %f, wanted: %f)", c.name, p, altitude, c.got, c.expected)
+				}
+			}
+		}
+	}
+}
+
+// legendre return the associated Legendre function of degree n and order m without Condon-Shortley phase
+func legendre(n, m int, x float64) float64 {
+	pmm := 1.0
+	for i := 1; i <= m; i++ {
+		pmm *= float64(2*i-1) * math.Sqrt(1-x*x)
+	}
+	if n == m {
+		return pmm
+	}
+
+	prev, p := pmm, x*float64(2*m+1)*pmm
+	for l := m + 2; l <= n; l++ {
+		prev, p = p, (float64(2*l-1)*x*p-float64(l+m-1)*prev)/float64(l-m)
+	}
+	return p
+}
+
+func factorial(n int) float64 {
+	f := 1.0
+	for i := 2; i <= n; i++ {
+		f *= float64(i)
+	}
+	return f
+}
@@ -0,0 +1,54 @@
+package nmea
+
+import "fmt"
+
+// Examples:
+// $GPWPL,4917.16,N,12310.64,W,003*65
+
+func NewWPL(m Message) *WPL {
+	return &WPL{Message: m}
+}
+
+// WPL is the location of a waypoint
+type WPL struct {
+	Message
+
+	Latitude          LatLong // In decimal format
+	Longitude         LatLong // In decimal format
+	LatitudeDecimals  *int    // Number of decimals of minutes in latitude data field (NoCoordinate if empty), DefaultCoordinateDecimals if not specified (nil)
+	LongitudeDecimals *int    // Number of decimals of minutes in longitude data field (NoCoordinate if empty), DefaultCoordinateDecimals if not specified (nil)
+	Name              string  // Waypoint identifier
+}
+
+func (m *WPL) parse() (err error) {
+	if len(m.Fields) != 5 {
+		return m.Error(fmt.Errorf("Incomplete WPL message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 5))
+	}
+
+	if m.Latitude, m.LatitudeDecimals, err = parseDMField(m.Fields[0], m.Fields[1]); err != nil {
+		return m.Error(err)
+	}
+
+	if m.Longitude, m.LongitudeDecimals, err = parseDMField(m.Fields[2], m.Fields[3]); err != nil {
+		return m.Error(err)
+	}
+
+	m.Name = m.Fields[4]
+
+	return nil
+}
+
+func (m WPL) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("WPL")
+	fields := make([]string, 0)
+
+	fields = append(fields, serializeDMField(m.Latitude, true, m.LatitudeDecimals)...)
+	fields = append(fields, serializeDMField(m.Longitude, false, m.LongitudeDecimals)...)
+	fields = append(fields, m.Name)
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
@@ -0,0 +1,229 @@
+package nmea
+
+import "fmt"
+
+// Examples:
+// $IIXDR,C,22.5,C,AIRTEMP,P,1.02481,B,BARO,H,64.2,P,HUMIDITY*30
+// $IIXDR,A,-2.5,D,PITCH,A,1.2,D,ROLL,U,12.6,V,BATT1*16
+// $IIXDR,G,1,,RELAY1*4A
+
+func NewXDR(m Message) *XDR {
+	return &XDR{Message: m}
+}
+
+// Transducer is a single measurement of a XDR message
+type Transducer struct {
+	Type  TransducerType
+	Value *float64 // Measurement expressed in Unit, empty if not available
+	Unit  TransducerUnit
+	Name  string // Transducer identifier
+}
+
+func newTransducerFromFields(f []string) (t Transducer, err error) {
+	if len(f) < 4 {
+		return t, fmt.Errorf("Not enought fields for create transducer")
+	}
+
+	t.Type, t.Unit, t.Name = TransducerType(f[0]), TransducerUnit(f[2]), f[3]
+
+	if t.Value, err = parseOptionalFloat(f[1]); err != nil {
+		return
+	}
+
+	if units, known := transducerUnits[t.Type]; known && len(f[2]) > 0 {
+		if _, ok := units[t.Unit]; !ok {
+			return t, fmt.Errorf("Wrong unit for %s transducer (got: %s)", t.Type, f[2])
+		}
+	}
+
+	return
+}
+
+type XDR struct {
+	Message
+
+	Transducers []Transducer
+}
+
+func (m *XDR) parse() (err error) {
+	if len(m.Fields) == 0 || len(m.Fields)%4 != 0 {
+		return m.Error(fmt.Errorf("Invalid message size (got: %d)", len(m.Fields)))
+	}
+
+	offset := 0
+	padding := 4
+	m.Transducers = make([]Transducer, 0)
+
+	for len(m.Fields[offset:]) != 0 {
+		transducer, err := newTransducerFromFields(m.Fields[offset : offset+padding])
+		if err != nil {
+			return m.Error(err)
+		}
+
+		m.Transducers = append(m.Transducers, transducer)
+		offset += padding
+	}
+
+	return nil
+}
+
+func (m XDR) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("XDR")
+	fields := make([]string, 0)
+
+	for i, t := range m.Transducers {
+		value := formatOptionalFloat(t.Value, m.field(i*4+1), "%.1f")
+		fields = append(fields, t.Type.Serialize(), value, t.Unit.Serialize(), t.Name)
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// Find return the first transducer matching type and name
+func (m XDR) Find(typ TransducerType, name string) *Transducer {
+	for i, t := range m.Transducers {
+		if t.Type == typ && t.Name == name {
+			return &m.Transducers[i]
+		}
+	}
+	return nil
+}
+
+const (
+	TransducerTypeAngle        TransducerType = "A" // Angular displacement
+	TransducerTypeTemperature  TransducerType = "C"
+	TransducerTypeDisplacement TransducerType = "D" // Linear displacement
+	TransducerTypeFrequency    TransducerType = "F"
+	TransducerTypeGeneric      TransducerType = "G"
+	TransducerTypeHumidity     TransducerType = "H"
+	TransducerTypeCurrent      TransducerType = "I"
+	TransducerTypeForce        TransducerType = "N"
+	TransducerTypePressure     TransducerType = "P"
+	TransducerTypeFlowRate     TransducerType = "R"
+	TransducerTypeSwitch       TransducerType = "S" // Switch or valve
+	TransducerTypeTachometer   TransducerType = "T"
+	TransducerTypeVoltage      TransducerType = "U"
+	TransducerTypeVolume       TransducerType = "V"
+)
+
+type TransducerType string
+
+func (t TransducerType) Serialize() string {
+	return string(t)
+}
+
+func (t TransducerType) String() string {
+	switch t {
+	case TransducerTypeAngle:
+		return "Angular displacement"
+	case TransducerTypeTemperature:
+		return "Temperature"
+	case TransducerTypeDisplacement:
+		return "Linear displacement"
+	case TransducerTypeFrequency:
+		return "Frequency"
+	case TransducerTypeGeneric:
+		return "Generic"
+	case TransducerTypeHumidity:
+		return "Humidity"
+	case TransducerTypeCurrent:
+		return "Current"
+	case TransducerTypeForce:
+		return "Force"
+	case TransducerTypePressure:
+		return "Pressure"
+	case TransducerTypeFlowRate:
+		return "Flow rate"
+	case TransducerTypeSwitch:
+		return "Switch or valve"
+	case TransducerTypeTachometer:
+		return "Tachometer"
+	case TransducerTypeVoltage:
+		return "Voltage"
+	case TransducerTypeVolume:
+		return "Volume"
+	default:
+		return "unknow"
+	}
+}
+
+const (
+	TransducerUnitDegrees TransducerUnit = "D"
+	TransducerUnitCelsius TransducerUnit = "C"
+	TransducerUnitMeters  TransducerUnit = "M" // Also cubic meters for volume
+	TransducerUnitHertz   TransducerUnit = "H"
+	TransducerUnitAmperes TransducerUnit = "A"
+	TransducerUnitNewton  TransducerUnit = "N"
+	TransducerUnitBar     TransducerUnit = "B"
+	TransducerUnitPascal  TransducerUnit = "P" // Pascal for pressure but percent for humidity, depends on transducer type
+	TransducerUnitLiters  TransducerUnit = "L" // Liters per second
+	TransducerUnitRPM     TransducerUnit = "R"
+	TransducerUnitVolts   TransducerUnit = "V"
+)
+
+// transducerUnits is a dictionary of allowed units by known transducer type
+var transducerUnits = map[TransducerType]map[TransducerUnit]struct{}{
+	TransducerTypeAngle:       {TransducerUnitDegrees: {}},
+	TransducerTypeTemperature: {TransducerUnitCelsius: {}},
+	TransducerTypePressure:    {TransducerUnitBar: {}, TransducerUnitPascal: {}},
+	TransducerTypeHumidity:    {TransducerUnitPascal: {}}, // Percent
+	TransducerTypeVoltage:     {TransducerUnitVolts: {}},
+}
+
+type TransducerUnit string
+
+func (u TransducerUnit) Serialize() string {
+	return string(u)
+}
+
+// ToSI convert value expressed in this unit to the SI unit (only bar need conversion to pascal)
+func (u TransducerUnit) ToSI(v float64) float64 {
+	if u == TransducerUnitBar {
+		return v * 100000
+	}
+	return v
+}
+
+// Celsius return temperature in degree Celsius, false if transducer is not a temperature
+func (t Transducer) Celsius() (float64, bool) {
+	if t.Type != TransducerTypeTemperature || t.Value == nil {
+		return 0, false
+	}
+	return *t.Value, true
+}
+
+// Pascal return pressure in pascal, false if transducer is not a pressure
+func (t Transducer) Pascal() (float64, bool) {
+	if t.Type != TransducerTypePressure || t.Value == nil {
+		return 0, false
+	}
+	return t.Unit.ToSI(*t.Value), true
+}
+
+// Degrees return angle in degree, false if transducer is not an angular displacement
+func (t Transducer) Degrees() (float64, bool) {
+	if t.Type != TransducerTypeAngle || t.Value == nil {
+		return 0, false
+	}
+	return *t.Value, true
+}
+
+// Percent return relative humidity in percent, false if transducer is not a humidity
+func (t Transducer) Percent() (float64, bool) {
+	if t.Type != TransducerTypeHumidity || t.Value == nil {
+		return 0, false
+	}
+	return *t.Value, true
+}
+
+// Volts return voltage in volts, false if transducer is not a voltage
+func (t Transducer) Volts() (float64, bool) {
+	if t.Type != TransducerTypeVoltage || t.Value == nil {
+		return 0, false
+	}
+	return *t.Value, true
+}
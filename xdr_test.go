@@ -0,0 +1,40 @@
+package nmea
+
+import (
+	"math"
+	"testing"
+)
+
+func TestXDR(t *testing.T) {
+	raw := "$IIXDR,C,22.5,C,AIRTEMP,P,1.02481,B,BARO,H,64.2,P,HUMIDITY*30"
+
+	msg, err := Parse(raw)
+	if err != nil {
+		t.Fatalf("Unable to parse \"%s\", err: %s", raw, err.Error())
+	}
+
+	xdr, ok := msg.(*XDR)
+	if !ok {
+		t.Fatalf("Wrong message type (got: %T)", msg)
+	}
+
+	if len(xdr.Transducers) != 3 {
+		t.Fatalf("Wrong number of transducers (got: %d, wanted: %d)", len(xdr.Transducers), 3)
+	}
+
+	if v, ok := xdr.Find(TransducerTypeTemperature, "AIRTEMP").Celsius(); !ok || v != 22.5 {
+		t.Fatalf("Wrong air temperature (got: %f)", v)
+	}
+
+	if v, ok := xdr.Find(TransducerTypePressure, "BARO").Pascal(); !ok || math.Abs(v-102481) > 1e-6 {
+		t.Fatalf("Wrong barometric pressure (got: %f)", v)
+	}
+
+	if _, ok := xdr.Find(TransducerTypeHumidity, "HUMIDITY").Volts(); ok {
+		t.Fatal("Humidity transducer shouldn't be converted to voltage")
+	}
+
+	if _, err := Parse("$IIXDR,C,22.5,B,AIRTEMP*02"); err == nil {
+		t.Fatal("Temperature transducer with pressure unit should be rejected")
+	}
+}
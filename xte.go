@@ -0,0 +1,130 @@
+package nmea
+
+import "fmt"
+
+// Examples:
+// $GPXTE,A,A,0.67,L,N*6F
+// $GPXTE,A,A,0.67,L,N,A*02
+
+func NewXTE(m Message) *XTE {
+	return &XTE{Message: m}
+}
+
+type XTE struct {
+	Message
+
+	IsValid         DataValid // General warning flag (Loran-C blink or SNR warning)
+	IsCycleLockOK   DataValid // Loran-C cycle lock warning flag (always valid for GPS)
+	CrossTrackError *float64  // Magnitude of cross-track error in nautical miles, empty if not available
+	SteerDirection  SteerDirection
+	PositioningMode PositioningMode // NMEA 2.3 and later, empty if not available
+}
+
+func (m *XTE) parse() (err error) {
+	if len(m.Fields) != 5 && len(m.Fields) != 6 {
+		return m.Error(fmt.Errorf("Incomplete XTE message, not enougth data fields (got: %d, wanted: %d or %d)", len(m.Fields), 5, 6))
+	}
+
+	if m.Fields[4] != "N" {
+		return m.Error(fmt.Errorf("Invalid fixed field at %d (got: %s, wanted: %s)", 5, m.Fields[4], "N"))
+	}
+
+	m.IsValid = (m.Fields[0] == "A")
+	m.IsCycleLockOK = (m.Fields[1] == "A")
+
+	if m.CrossTrackError, m.SteerDirection, err = parseCrossTrackError(m.Fields[2], m.Fields[3]); err != nil {
+		return m.Error(err)
+	}
+
+	if len(m.Fields) == 6 {
+		if m.PositioningMode, err = ParsePositioningMode(m.Fields[5]); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse GPS positioning mode from data field (got: %s)", m.Fields[5]))
+		}
+	}
+
+	return nil
+}
+
+func (m XTE) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("XTE")
+	fields := make([]string, 0)
+
+	fields = append(fields,
+		m.IsValid.Serialize(),
+		m.IsCycleLockOK.Serialize(),
+		formatOptionalFloat(m.CrossTrackError, m.field(2), "%.2f"),
+		m.SteerDirection.Serialize(),
+		"N",
+	)
+
+	if len(m.PositioningMode) > 0 {
+		fields = append(fields, m.PositioningMode.Serialize())
+	}
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// parseCrossTrackError return magnitude (nil if empty) and direction to steer from data fields
+func parseCrossTrackError(magnitude, direction string) (xte *float64, dir SteerDirection, err error) {
+	if xte, err = parseOptionalFloat(magnitude); err != nil {
+		return xte, dir, fmt.Errorf("Unable to parse cross-track error from data field (got: %s)", magnitude)
+	}
+
+	if len(direction) > 0 {
+		if dir, err = ParseSteerDirection(direction); err != nil {
+			return xte, dir, fmt.Errorf("Unable to parse direction to steer from data field (got: %s)", direction)
+		}
+	}
+
+	return
+}
+
+const (
+	SteerLeft  SteerDirection = "L"
+	SteerRight SteerDirection = "R"
+)
+
+type SteerDirection string
+
+func (s SteerDirection) Serialize() string {
+	return string(s)
+}
+
+func (s SteerDirection) String() string {
+	switch s {
+	case SteerLeft:
+		return "Left"
+	case SteerRight:
+		return "Right"
+	default:
+		return "unknow"
+	}
+}
+
+func ParseSteerDirection(raw string) (s SteerDirection, err error) {
+	s = SteerDirection(raw)
+	switch s {
+	case SteerLeft, SteerRight:
+	default:
+		err = fmt.Errorf("unknow value")
+	}
+	return
+}
+
+const (
+	Arrived    ArrivalStatus = true
+	NotArrived ArrivalStatus = false
+)
+
+type ArrivalStatus bool
+
+func (a ArrivalStatus) Serialize() string {
+	if a == Arrived {
+		return "A"
+	}
+	return "V"
+}
@@ -0,0 +1,96 @@
+package nmea
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Examples:
+// $GPZDA,201530.00,04,07,2002,00,00*60
+// $GPZDA,160012.71,11,03,2004,-01,00*4D
+
+func NewZDA(m Message) *ZDA {
+	return &ZDA{Message: m}
+}
+
+type ZDA struct {
+	Message
+
+	DateTimeUTC      time.Time // Aggregation of TimeUTC+Day+Month+Year data field, zero if not available
+	TimeDigits       int       // Number of fractional digits of TimeUTC data field
+	LocalZoneHours   int       // Local zone hours offset from UTC (-13 to 13)
+	LocalZoneMinutes int       // Local zone minutes offset from UTC (same sign as hours)
+}
+
+func (m *ZDA) parse() (err error) {
+	if len(m.Fields) != 6 {
+		return m.Error(fmt.Errorf("Incomplete ZDA message, not enougth data fields (got: %d, wanted: %d)", len(m.Fields), 6))
+	}
+
+	timeUTC, digits, err := parseTimeOfDay(m.Fields[0])
+	if err != nil {
+		return m.Error(fmt.Errorf("Unable to parse time UTC from data field (got: %s)", m.Fields[0]))
+	}
+	m.TimeDigits = digits
+
+	if len(m.Fields[1]) > 0 || len(m.Fields[2]) > 0 || len(m.Fields[3]) > 0 {
+		date := fmt.Sprintf("%s/%s/%s", m.Fields[1], m.Fields[2], m.Fields[3])
+		if m.DateTimeUTC, err = time.Parse("02/01/2006", date); err != nil {
+			return m.Error(fmt.Errorf("Unable to parse date UTC from data field (got: %s)", date))
+		}
+		if !timeUTC.IsZero() {
+			m.DateTimeUTC = m.DateTimeUTC.Add(timeUTC.Sub(time.Date(0, 1, 1, 0, 0, 0, 0, time.UTC)))
+		}
+	} else {
+		m.DateTimeUTC = timeUTC
+	}
+
+	if hours := m.Fields[4]; len(hours) > 0 {
+		if m.LocalZoneHours, err = strconv.Atoi(hours); err != nil || m.LocalZoneHours < -13 || m.LocalZoneHours > 13 {
+			return m.Error(fmt.Errorf("Unable to parse local zone hours from data field (got: %s)", hours))
+		}
+	}
+
+	if minutes := m.Fields[5]; len(minutes) > 0 {
+		if m.LocalZoneMinutes, err = strconv.Atoi(minutes); err != nil || m.LocalZoneMinutes < -59 || m.LocalZoneMinutes > 59 {
+			return m.Error(fmt.Errorf("Unable to parse local zone minutes from data field (got: %s)", minutes))
+		}
+	}
+
+	return nil
+}
+
+func (m ZDA) Serialize() string { // Implement NMEA interface
+
+	hdr := m.header("ZDA")
+	fields := make([]string, 0)
+
+	// Midnight of a date without time of day is kept empty
+	if len(m.Fields) > 0 && len(m.Fields[0]) == 0 && m.DateTimeUTC.Equal(m.DateTimeUTC.Truncate(24*time.Hour)) {
+		fields = append(fields, "")
+	} else {
+		fields = append(fields, serializeTimeOfDay(m.DateTimeUTC, m.TimeDigits))
+	}
+
+	if m.DateTimeUTC.IsZero() || m.DateTimeUTC.Year() == 0 {
+		fields = append(fields, "", "", "")
+	} else {
+		fields = append(fields, m.DateTimeUTC.Format("02"), m.DateTimeUTC.Format("01"), m.DateTimeUTC.Format("2006"))
+	}
+
+	fields = append(fields, serializeZoneOffset(m.LocalZoneHours), serializeZoneOffset(m.LocalZoneMinutes))
+
+	msg := Message{Type: hdr, Fields: fields}
+	msg.Checksum = msg.ComputeChecksum()
+
+	return msg.Serialize()
+}
+
+// serializeZoneOffset return two digits zone offset with sign when negative (ie: "-05")
+func serializeZoneOffset(v int) string {
+	if v < 0 {
+		return fmt.Sprintf("-%02d", -v)
+	}
+	return fmt.Sprintf("%02d", v)
+}